@@ -13,7 +13,13 @@ type Arguments struct {
 	KeyPairName        *string
 	KeyPairDescription *string
 	KeyPairAlgo        *string
+	KeyPairSize        *uint32
+	KeyPairOAEPHash    *string
+	KeyPairCipher      *string
 	KeyExpiration      *time.Duration
+	KeyActivation      *time.Duration
+	KeyRotateEvery     *time.Duration
+	KeyRotateAfter     *uint64
 
 	// KEY MOD
 	KeyIdMod                *string
@@ -21,15 +27,59 @@ type Arguments struct {
 	KeyPairDescriptionMod   *string
 	KeyExpirationMod        *time.Duration
 	KeyExpirationDisableMod *bool
+	KeyActivationMod        *time.Duration
+	KeyActivationNowMod     *bool
+	KeyRotateEveryMod       *time.Duration
+	KeyRotateAfterMod       *uint64
+	KeyRotateDisableMod     *bool
 
 	// KEY REMOVE
-	KeyIdRem *string
+	KeyIdRem      *string
+	KeyRemCascade *bool
+	KeyRemOrphan  *bool
+	KeyRemDryRun  *bool
+
+	// KEY MIGRATE
+	KeyIdMigrate    *string
+	MigratePath     *string
+	MigrateCertPath *string
+
+	// KEY ROTATE
+	KeyIdRotate *string
+
+	// KEY VERSION
+	KeyIdVersion    *string
+	KeyVersion      *uint32
+	KeyVersionState *string
+
+	// KEY STATE
+	KeyIdEnable          *string
+	KeyEnableDecryptOnly *bool
+	KeyIdDisable         *string
+	KeyIdDestroy         *string
+
+	// KEY SHOW
+	KeyIdShow      *string
+	KeyShowFormat  *string
+	KeyShowVersion *uint32
 
 	// KEY EXPORT/IMPORT
-	KeyExportFilePath *string
-	KeyExportKeyId    *string
-	KeyImportFilePath *string
-	KeyImportKeyId    *string
+	KeyExportFilePath   *string
+	KeyExportKeyId      *string
+	KeyExportPassphrase *string
+	KeyExportPlaintext  *bool
+	KeyImportFilePath   *string
+	KeyImportKeyId      *string
+	KeyImportPassphrase *string
+	KeyImportFormat     *string
+	KeyImportCascade    *bool
+	KeyImportOrphan     *bool
+
+	// KEY TRANSFER
+	KeyTransferKeyId     *string
+	KeyTransferDestKeyId *string
+	KeyTransferFrom      *string
+	KeyTransferTo        *string
 
 	// LIST
 	ListStoragePath *string
@@ -59,6 +109,12 @@ type Arguments struct {
 	ImportBackup           *string
 	RestoreFromBackup      *string
 
+	// OPERATOR
+	OperatorShares      *uint32
+	OperatorThreshold   *uint32
+	OperatorUnsealShare *string
+	OperatorUnsealReset *bool
+
 	// REMOVE/FORCE
 	RemoveFile *string
 	Force      *bool // Used to force overwrite
@@ -78,6 +134,9 @@ func ParseArguments() (string, *Arguments) {
 	listKeysCmd := listCmd.Command("keys", "Retrieves available keys with their name and public key")
 	args.GetKeyNames = listKeysCmd.Flag("names", "Retrieves available key names only").Bool()
 
+	// LIST: Key Algorithms
+	listCmd.Command("algorithms", "Retrieves key algorithms supported by the server")
+
 	// LIST: Internal Storage
 	listStorageCmd := listCmd.Command("storage", "List an internal path")
 	args.ListStoragePath = listStorageCmd.Flag("path", "Internal path to data").Default("/").String()
@@ -93,28 +152,86 @@ func ParseArguments() (string, *Arguments) {
 	args.KeyPairDescriptionMod = keyModCmd.Flag("description", "Modify key description").Default("").String()
 	args.KeyExpirationMod = keyModCmd.Flag("expire", "Set expiration duration for given key, making the key read-only").Default("0s").Duration()
 	args.KeyExpirationDisableMod = keyModCmd.Flag("no-expire", "Disable key expiration for given key").Default("false").Bool()
+	args.KeyActivationMod = keyModCmd.Flag("activate-in", "Set activation delay for given key, unable to be used until then").Default("0s").Duration()
+	args.KeyActivationNowMod = keyModCmd.Flag("activate-now", "Remove the activation delay of given key").Default("false").Bool()
+	args.KeyRotateEveryMod = keyModCmd.Flag("rotate-every", "Set the rotation policy of given key, rotating once its latest version is older").Default("0s").Duration()
+	args.KeyRotateAfterMod = keyModCmd.Flag("rotate-after", "Set the rotation policy of given key, rotating once its latest version encrypted as many files").Default("0").Uint64()
+	args.KeyRotateDisableMod = keyModCmd.Flag("no-rotate", "Remove the rotation policy of given key").Default("false").Bool()
 
 	// KEY: Remove
 	keyRemCmd := keyCmd.Command("remove", "Key removal sub-menu")
 	args.KeyIdRem = keyRemCmd.Flag("key-id", "Key name to remove").Required().String()
+	args.KeyRemCascade = keyRemCmd.Flag("cascade", "Removes stored files encrypted with the key along with it").Default("false").Bool()
+	args.KeyRemOrphan = keyRemCmd.Flag("orphan", "Keeps stored files encrypted with the key, making them unrecoverable").Default("false").Bool()
+	args.KeyRemDryRun = keyRemCmd.Flag("dry-run", "Previews the removal without removing anything").Default("false").Bool()
+
+	// KEY: Cipher Migration
+	keyMigrateCmd := keyCmd.Command("migrate", "Re-encrypts stored files using the key's authenticated cipher")
+	args.KeyIdMigrate = keyMigrateCmd.Flag("key-id", "Key name the stored files were encrypted with").Required().String()
+	args.MigratePath = keyMigrateCmd.Flag("path", "Internal path to recursively migrate").Default("/").String()
+	args.MigrateCertPath = keyMigrateCmd.Flag("cert-path", "Certifact path used to verify user").String()
+
+	// KEY: Rotation
+	keyRotateCmd := keyCmd.Command("rotate", "Rotates the key's material, re-wrapping or re-encrypting its stored files")
+	args.KeyIdRotate = keyRotateCmd.Flag("key-id", "Key name to rotate").Required().String()
+
+	// KEY: Version
+	keyVersionCmd := keyCmd.Command("version", "Enables, disables or destroys a key's version")
+	args.KeyIdVersion = keyVersionCmd.Flag("key-id", "Key name of the version").Required().String()
+	args.KeyVersion = keyVersionCmd.Flag("version", "Key version to modify").Required().Uint32()
+	args.KeyVersionState = keyVersionCmd.Flag("state", "Key version's new state, destroying requires --force").Required().Enum("enabled", "disabled", "destroyed")
+
+	// KEY: Lifecycle State
+	keyEnableCmd := keyCmd.Command("enable", "Enables a key, encrypting & decrypting or decrypting only")
+	args.KeyIdEnable = keyEnableCmd.Flag("key-id", "Key name to enable").Required().String()
+	args.KeyEnableDecryptOnly = keyEnableCmd.Flag("decrypt-only", "Enables the key for decryption only, refusing new encryptions").Default("false").Bool()
+	keyDisableCmd := keyCmd.Command("disable", "Disables a key, refusing to encrypt or decrypt until re-enabled")
+	args.KeyIdDisable = keyDisableCmd.Flag("key-id", "Key name to disable").Required().String()
+	keyDestroyCmd := keyCmd.Command("destroy", "Destroys a key's material keeping its metadata, requires --force")
+	args.KeyIdDestroy = keyDestroyCmd.Flag("key-id", "Key name to destroy").Required().String()
+
+	// KEY: Show
+	keyShowCmd := keyCmd.Command("show", "Prints a key's public key in a standard format along with its fingerprint, verifying it out of band")
+	args.KeyIdShow = keyShowCmd.Flag("key-id", "Key name to show").Required().String()
+	args.KeyShowFormat = keyShowCmd.Flag("format", "Public key format: pem (PKIX), jwk or ssh (authorized_keys)").Default("pem").Enum("pem", "jwk", "ssh")
+	args.KeyShowVersion = keyShowCmd.Flag("version", "Key version to show. Default: Latest version").Default("0").Uint32()
 
 	// KEY: Generation
 	keyGenerateCmd := keyCmd.Command("generate", "Generate Keypair given key metadata")
 	args.KeyPairName = keyGenerateCmd.Flag("name", "Generated key's name").Required().String()
 	args.KeyPairDescription = keyGenerateCmd.Flag("description", "Generated key's description").Default("").String()
-	args.KeyPairAlgo = keyGenerateCmd.Flag("algorithm", "Generated key's algorithm").Default("rsa").Enum("rsa", "none", "ed25519")
+	args.KeyPairAlgo = keyGenerateCmd.Flag("algorithm", "Generated key's algorithm, see 'list algorithms'. Default: Server's default algorithm").String()
+	args.KeyPairSize = keyGenerateCmd.Flag("key-size", "Generated RSA key's size in bits (2048/3072/4096). Default: Server's default key size").Default("0").Uint32()
+	args.KeyPairOAEPHash = keyGenerateCmd.Flag("oaep-hash", "Generated RSA key's OAEP hash. Default: Server's default OAEP hash").Enum("sha256", "sha384", "sha512")
+	args.KeyPairCipher = keyGenerateCmd.Flag("cipher", "Generated key's file data cipher, see 'list algorithms'. Default: Server's default cipher").String()
 	args.KeyExpiration = keyGenerateCmd.Flag("expire", "Set expiration duration for generated key").Default("0").Duration()
+	args.KeyActivation = keyGenerateCmd.Flag("activate-in", "Set activation delay for generated key, unable to be used until then").Default("0").Duration()
+	args.KeyRotateEvery = keyGenerateCmd.Flag("rotate-every", "Rotate generated key once its latest version is older").Default("0").Duration()
+	args.KeyRotateAfter = keyGenerateCmd.Flag("rotate-after", "Rotate generated key once its latest version encrypted as many files").Default("0").Uint64()
 	args.KeyCertOutput = keyGenerateCmd.Flag("cert-out", "Certificate output path for signing keys").Default("./").String()
 
 	// KEY: Export
 	keyExportCmd := keyCmd.Command("export", "Export key sub-menu")
 	args.KeyExportFilePath = keyExportCmd.Flag("dest", "Destination of exported keys").Required().String()
 	args.KeyExportKeyId = keyExportCmd.Flag("key-id", "Key's id/name to export").Required().String()
+	args.KeyExportPassphrase = keyExportCmd.Flag("passphrase", "Passphrase the exported key is encrypted with. Default: Prompts for the passphrase").Default("").String()
+	args.KeyExportPlaintext = keyExportCmd.Flag("insecure-plaintext", "Exports the key unencrypted, anyone holding the export holds the key").Bool()
 
 	// KEY: Import
 	keyImportCmd := keyCmd.Command("import", "Import key sub-menu")
 	args.KeyImportFilePath = keyImportCmd.Flag("path", "Path to key that will be imported").Required().String()
 	args.KeyImportKeyId = keyImportCmd.Flag("key-id", "Key's id/name to be imported to").Required().String()
+	args.KeyImportPassphrase = keyImportCmd.Flag("passphrase", "Passphrase the key was exported with, or protecting an OpenSSH key. Default: Prompts for the passphrase if encrypted").Default("").String()
+	args.KeyImportFormat = keyImportCmd.Flag("format", "Format of the imported key: an exported package, or an RSA/Ed25519 private key (pem, openssh, jwk)").Default("package").Enum("package", "pem", "openssh", "jwk")
+	args.KeyImportCascade = keyImportCmd.Flag("cascade", "Removes stored files encrypted with the overwritten key").Default("false").Bool()
+	args.KeyImportOrphan = keyImportCmd.Flag("orphan", "Keeps stored files encrypted with the overwritten key, which may become unrecoverable").Default("false").Bool()
+
+	// KEY: Transfer
+	keyTransferCmd := keyCmd.Command("transfer", "Transfers a key between servers, sealed for the destination server's transport key")
+	args.KeyTransferKeyId = keyTransferCmd.Flag("key-id", "Key's id/name to transfer").Required().String()
+	args.KeyTransferDestKeyId = keyTransferCmd.Flag("dest-key-id", "Key's id/name on the destination server. Default: Same key id").Default("").String()
+	args.KeyTransferFrom = keyTransferCmd.Flag("from", "Profile of the source server, see 'profiles' within the client configuration").Default("default").String()
+	args.KeyTransferTo = keyTransferCmd.Flag("to", "Profile of the destination server").Required().String()
 
 	// ENCRYPT
 	encryptCmd := kingpin.Command("encrypt", "Encrypts given path, storing it in given storage path")
@@ -126,7 +243,7 @@ func ParseArguments() (string, *Arguments) {
 	// DECRYPT
 	decryptCmd := kingpin.Command("decrypt", "Decrypts file from given path, responding with file data")
 	args.DecryptFile = decryptCmd.Flag("path", "Path to the file to decrypt on server").Required().String()
-	args.DecryptKeyId = decryptCmd.Flag("key-id", "Key's id/name used to encrypt").Required().String()
+	args.DecryptKeyId = decryptCmd.Flag("key-id", "Key's id/name used to encrypt. Default: Key recorded when encrypted").Default("").String()
 	args.FilePacketOutput = decryptCmd.Flag("dest", "Destination for incoming file packet data. Default: Outputs to stdout").Default("").String()
 	args.DecryptCertPath = decryptCmd.Flag("cert-path", "Certifact path used to verify user").String()
 
@@ -159,6 +276,23 @@ func ParseArguments() (string, *Arguments) {
 	backupRestoreCmd := backupImportCmd.Command("restore", "Restore backups from server storage")
 	args.RestoreFromBackup = backupRestoreCmd.Flag("name", "Backup name used to restore").Default("").String()
 
+	// OPERATOR
+	operatorCmd := kingpin.Command("operator", "Server operator sub-menu")
+
+	// OPERATOR: Init
+	operatorInitCmd := operatorCmd.Command("init", "Splits the server's master key into unseal shares, the server starting sealed from then on")
+	args.OperatorShares = operatorInitCmd.Flag("shares", "Number of unseal shares to split the master key into").Default("5").Uint32()
+	args.OperatorThreshold = operatorInitCmd.Flag("threshold", "Number of unseal shares required to unseal the server").Default("3").Uint32()
+
+	// OPERATOR: Unseal
+	operatorUnsealCmd := operatorCmd.Command("unseal", "Submits an unseal share, unsealing the server once enough shares were submitted")
+	args.OperatorUnsealShare = operatorUnsealCmd.Flag("share", "Unseal share to submit. Default: Prompts for the share").Default("").String()
+	args.OperatorUnsealReset = operatorUnsealCmd.Flag("reset", "Discards previously submitted unseal shares").Bool()
+
+	// OPERATOR: Seal & Status
+	operatorCmd.Command("seal", "Seals the server, wiping its master key & keys from memory")
+	operatorCmd.Command("status", "Retrieves the server's seal status")
+
 	// REMOVE
 	removeCmd := kingpin.Command("remove", "Remove an internal entry")
 	args.RemoveFile = removeCmd.Flag("path", "Internal file to remove").Required().String()
@@ -1,14 +1,23 @@
 package configuration
 
-// Configuraiton Structure
-type Configuration struct {
-	GrpcName    string `json:"grpcName"`
+// Server Profile, connecting to another server than the configured one
+type Profile struct {
 	GrpcHost    string `json:"grpcHost"`
 	GrpcPort    uint16 `json:"grpcPort"`
 	Insecure    bool   `json:"insecure"`
 	TLSCertPath string `json:"tlsCertPath"`
 }
 
+// Configuraiton Structure
+type Configuration struct {
+	GrpcName    string             `json:"grpcName"`
+	GrpcHost    string             `json:"grpcHost"`
+	GrpcPort    uint16             `json:"grpcPort"`
+	Insecure    bool               `json:"insecure"`
+	TLSCertPath string             `json:"tlsCertPath"`
+	Profiles    map[string]Profile `json:"profiles,omitempty"` // Named server profiles
+}
+
 // Assigned Default Values
 var (
 	LoadedConfig = Configuration{
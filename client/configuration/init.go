@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"log"
 	"openabyss/utils"
@@ -58,3 +59,19 @@ func Init() {
 		}
 	}
 }
+
+// Returns the server profile by name, the "default" profile being the configured server
+func GetProfile(name string) (Profile, error) {
+	if profile, ok := LoadedConfig.Profiles[name]; ok {
+		return profile, nil
+	}
+	if name == "" || name == "default" {
+		return Profile{
+			GrpcHost:    LoadedConfig.GrpcHost,
+			GrpcPort:    LoadedConfig.GrpcPort,
+			Insecure:    LoadedConfig.Insecure,
+			TLSCertPath: LoadedConfig.TLSCertPath,
+		}, nil
+	}
+	return Profile{}, errors.New("profile '" + name + "' not found")
+}
@@ -4,12 +4,12 @@ import "os"
 
 // Fatalln Print and exit
 func Fatalln(v ...interface{}) {
-	Error.Println(v)
+	Error.Println(v...)
 	os.Exit(1)
 }
 
 // Fatalf Print and exit
 func Fatalf(format string, v ...interface{}) {
-	Error.Printf(format, v)
+	Error.Printf(format, v...)
 	os.Exit(1)
 }
@@ -1,5 +1,6 @@
 package main
 
 var (
-	version = "0.1.1"
+	version       = "0.1.1"
+	fileChunkSize = 64 * 1024 // Size in bytes of streamed file chunks
 )
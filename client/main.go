@@ -1,12 +1,15 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
 	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -26,11 +29,15 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
+// Buffered stdin, shared by prompts
+var stdinReader = bufio.NewReader(os.Stdin)
+
 // Encapsulates references for Client
 type ClientContext struct {
-	pbClient pb.OpenAbyssClient
-	ctx      context.Context
-	args     *Arguments
+	pbClient  pb.OpenAbyssClient
+	ctx       context.Context
+	streamCtx context.Context // Context without a deadline, used for file streams & prompted requests
+	args      *Arguments
 }
 
 // Helper function that prints entity details
@@ -42,6 +49,15 @@ func printEntity(entity *pb.Entity) {
 	console.Heading.Printf("== [%s] ==\n", entity.Name)
 	console.Log.Println("- Description: ", entity.Description)
 	console.Log.Println("- Algorithm: ", entity.Algorithm)
+	if entity.KeySize != 0 {
+		console.Log.Println("- Key Size: ", entity.KeySize)
+	}
+	if entity.OAEPHash != "" {
+		console.Log.Println("- OAEP Hash: ", entity.OAEPHash)
+	}
+	if entity.CipherAlgorithm != "" {
+		console.Log.Println("- Cipher: ", entity.CipherAlgorithm)
+	}
 
 	console.Log.Println("- Created on: ", created_at.Local())
 	console.Log.Println("- Modified on: ", modified_at.Local())
@@ -52,6 +68,46 @@ func printEntity(entity *pb.Entity) {
 		console.Log.Println("- Expires on: ", "NEVER")
 	}
 
+	if entity.RotationPolicy != nil {
+		if entity.RotationPolicy.EveryUnixTimestamp != 0 {
+			console.Log.Println("- Rotates every: ", time.Duration(entity.RotationPolicy.EveryUnixTimestamp)*time.Millisecond)
+		}
+		if entity.RotationPolicy.AfterEncryptions != 0 {
+			console.Log.Printf("- Rotates after: %d encryptions\n", entity.RotationPolicy.AfterEncryptions)
+		}
+	}
+
+	if entity.NotBeforeUnixTimestamp != 0 {
+		console.Log.Println("- Not before: ", time.UnixMilli(int64(entity.NotBeforeUnixTimestamp)).Local())
+	}
+	if entity.Status != "" {
+		console.Log.Println("- Status: ", entity.Status)
+	}
+	if entity.State != "" {
+		console.Log.Println("- State: ", entity.State)
+	}
+	if len(entity.StateTransitions) > 0 {
+		console.Log.Println("- State History:")
+		for _, transition := range entity.StateTransitions {
+			console.Log.Printf("  - %s on: %s\n", transition.State, time.UnixMilli(int64(transition.ChangedUnixTimestamp)).Local())
+		}
+	}
+
+	if len(entity.Versions) > 0 {
+		console.Log.Println("- Versions:")
+		for idx, keyVersion := range entity.Versions {
+			latest := ""
+			if idx == len(entity.Versions)-1 {
+				latest = " (latest)"
+			}
+			console.Log.Printf("  - v%d [%s] Created on: %s, %d encryptions%s\n", keyVersion.Version, keyVersion.State, time.UnixMilli(int64(keyVersion.CreatedUnixTimestamp)).Local(), keyVersion.Encryptions, latest)
+		}
+	}
+
+	if entity.Fingerprint != "" {
+		console.Log.Println("- Fingerprint (SHA-256): ", entity.Fingerprint)
+	}
+
 	if len(entity.PublicKeyName) > 0 {
 		console.Log.Println("- Public Key:")
 		console.Log.Println(string(entity.PublicKeyName))
@@ -69,18 +125,32 @@ func printEntity(entity *pb.Entity) {
 func handleKeysSubCmd(actions []string, context *ClientContext) {
 	switch actions[0] {
 	case "generate":
+		keyNotBefore := uint64(0)
+		if context.args.KeyActivation.Milliseconds() != 0 {
+			keyNotBefore = uint64(time.Now().Add(*context.args.KeyActivation).UnixMilli())
+		}
+
 		resp, err := context.pbClient.GenerateKeyPair(context.ctx, &pb.GenerateEntityRequest{
 			Name:                   *context.args.KeyPairName,
 			Description:            *context.args.KeyPairDescription,
 			Algorithm:              *context.args.KeyPairAlgo,
 			ExpiresInUnixTimestamp: uint64(context.args.KeyExpiration.Milliseconds()),
+			KeySize:                *context.args.KeyPairSize,
+			OAEPHash:               *context.args.KeyPairOAEPHash,
+			CipherAlgorithm:        *context.args.KeyPairCipher,
+			NotBeforeUnixTimestamp: keyNotBefore,
+			RotationPolicy: &pb.EntityRotationPolicy{
+				EveryUnixTimestamp: uint64(context.args.KeyRotateEvery.Milliseconds()),
+				AfterEncryptions:   *context.args.KeyRotateAfter,
+			},
 		})
 		utils.HandleErr(err, "could not generate keypair for given name")
 
 		if err == nil {
 			console.Heading.Printf("Generated keypair for '%s':\n", color.WhiteString(resp.Name))
 
-			if pkSeed, err := base64.StdEncoding.DecodeString(resp.SigningPrivateKeySeed); err == nil {
+			// Only signing keys respond with a private key seed
+			if pkSeed, err := base64.StdEncoding.DecodeString(resp.SigningPrivateKeySeed); err == nil && len(pkSeed) == ed25519.SeedSize {
 				sk := ed25519.NewKeyFromSeed(pkSeed)
 
 				// Create Public/Private PEM key formats
@@ -101,6 +171,25 @@ func handleKeysSubCmd(actions []string, context *ClientContext) {
 		if context.args.KeyExpirationMod.Milliseconds() != 0 || *context.args.KeyExpirationDisableMod {
 			modifyKeyExpiration = true
 		}
+		modifyKeyNotBefore := false
+		keyNotBefore := uint64(0)
+		if context.args.KeyActivationMod.Milliseconds() != 0 {
+			modifyKeyNotBefore = true
+			keyNotBefore = uint64(time.Now().Add(*context.args.KeyActivationMod).UnixMilli())
+		} else if *context.args.KeyActivationNowMod {
+			modifyKeyNotBefore = true
+		}
+		modifyRotationPolicy := false
+		rotationPolicy := &pb.EntityRotationPolicy{
+			EveryUnixTimestamp: uint64(context.args.KeyRotateEveryMod.Milliseconds()),
+			AfterEncryptions:   *context.args.KeyRotateAfterMod,
+		}
+		if rotationPolicy.EveryUnixTimestamp != 0 || rotationPolicy.AfterEncryptions != 0 {
+			modifyRotationPolicy = true
+		} else if *context.args.KeyRotateDisableMod {
+			modifyRotationPolicy = true
+			rotationPolicy = nil
+		}
 
 		resp, err := context.pbClient.ModifyKeyPair(context.ctx, &pb.EntityModifyRequest{
 			Name:                   *context.args.KeyPairNameMod,
@@ -108,6 +197,10 @@ func handleKeysSubCmd(actions []string, context *ClientContext) {
 			KeyId:                  *context.args.KeyIdMod,
 			ModifyKeyExpiration:    modifyKeyExpiration,
 			ExpiresInUnixTimestamp: uint64(context.args.KeyExpirationMod.Milliseconds()),
+			ModifyKeyNotBefore:     modifyKeyNotBefore,
+			NotBeforeUnixTimestamp: keyNotBefore,
+			ModifyRotationPolicy:   modifyRotationPolicy,
+			RotationPolicy:         rotationPolicy,
 		})
 		utils.HandleErr(err, "could not modify key details for given key-id")
 
@@ -116,34 +209,221 @@ func handleKeysSubCmd(actions []string, context *ClientContext) {
 			printEntity(resp)
 		}
 	case "remove":
+		mode := ""
+		if *context.args.KeyRemCascade && *context.args.KeyRemOrphan {
+			console.Fatalln("only one of --cascade or --orphan may be issued")
+		} else if *context.args.KeyRemCascade {
+			mode = "cascade"
+		} else if *context.args.KeyRemOrphan {
+			mode = "orphan"
+		}
+		if mode != "" && !*context.args.KeyRemDryRun && !*context.args.Force {
+			console.Fatalln("removing a key along with, or orphaning, its dependent files is irreversible. Issue --force to remove")
+		}
+
 		resp, err := context.pbClient.RemoveKeyPair(context.ctx, &pb.EntityRemoveRequest{
-			KeyId: *context.args.KeyIdRem,
+			KeyId:  *context.args.KeyIdRem,
+			Mode:   mode,
+			DryRun: *context.args.KeyRemDryRun,
 		})
 		utils.HandleErr(err, "could not remove key for given key-id")
 
 		if err == nil {
-			console.Heading.Printf("Key '%s' successfully removed:\n", color.WhiteString(*context.args.KeyIdRem))
+			if len(resp.DependentPaths) > 0 {
+				console.Warning.Printf("%d stored files are encrypted with '%s':\n", len(resp.DependentPaths), color.WhiteString(*context.args.KeyIdRem))
+				for _, filePath := range resp.DependentPaths {
+					console.Log.Println("-", filePath)
+				}
+			}
+
+			if resp.Removed {
+				console.Heading.Printf("Key '%s' successfully removed:\n", color.WhiteString(*context.args.KeyIdRem))
+				printEntity(resp.Entity)
+				if len(resp.RemovedPaths) > 0 {
+					console.Heading.Printf("Removed %d stored files along with the key\n", len(resp.RemovedPaths))
+				}
+			} else if *context.args.KeyRemDryRun && (mode != "" || len(resp.DependentPaths) == 0) {
+				console.Heading.Printf("Key '%s' would be removed:\n", color.WhiteString(*context.args.KeyIdRem))
+				printEntity(resp.Entity)
+			} else {
+				console.Fatalln("key has dependent files, issue --cascade to remove them or --orphan to keep them unrecoverable")
+			}
+		}
+	case "migrate":
+		// Sign the path if signing certificate is present
+		var pathSig []byte
+		if len(*context.args.MigrateCertPath) > 0 {
+			if certFile, err := ioutil.ReadFile(*context.args.MigrateCertPath); err != nil {
+				console.Error.Println("Failed to read Certificate:", err)
+			} else if sk, err := utils.ParseSigningKey(certFile); err != nil {
+				console.Error.Println("Failed to parse Certificate:", err)
+			} else {
+				pathSig = ed25519.Sign(sk, []byte(*context.args.MigratePath))
+			}
+		}
+
+		resp, err := context.pbClient.MigrateStorageCipher(context.streamCtx, &pb.CipherMigrationRequest{
+			KeyName:       *context.args.KeyIdMigrate,
+			Path:          *context.args.MigratePath,
+			PathSignature: pathSig,
+		})
+		utils.HandleErr(err, "could not migrate stored files for given key-id")
+
+		if err == nil {
+			console.Heading.Printf("Migrated %d stored files for '%s':\n", len(resp.MigratedPaths), color.WhiteString(*context.args.KeyIdMigrate))
+			for _, filePath := range resp.MigratedPaths {
+				console.Log.Println("-", filePath)
+			}
+			if len(resp.FailedPaths) > 0 {
+				console.Warning.Printf("Failed to migrate %d stored files:\n", len(resp.FailedPaths))
+				for _, filePath := range resp.FailedPaths {
+					console.Log.Println("-", filePath)
+				}
+			}
+		}
+	case "version":
+		if *context.args.KeyVersionState == "destroyed" && !*context.args.Force {
+			console.Fatalln("destroying a key version is irreversible, files encrypted with it become unrecoverable. Issue --force to destroy")
+		}
+
+		resp, err := context.pbClient.ModifyKeyVersion(context.ctx, &pb.KeyVersionModifyRequest{
+			KeyId:   *context.args.KeyIdVersion,
+			Version: *context.args.KeyVersion,
+			State:   *context.args.KeyVersionState,
+		})
+		utils.HandleErr(err, "could not modify key version")
+
+		if err == nil {
+			console.Heading.Printf("Key '%s' version %d %s:\n", color.WhiteString(*context.args.KeyIdVersion), *context.args.KeyVersion, *context.args.KeyVersionState)
 			printEntity(resp)
 		}
+	case "enable":
+		resp, err := context.pbClient.EnableKey(context.ctx, &pb.KeyStateRequest{
+			KeyId:       *context.args.KeyIdEnable,
+			DecryptOnly: *context.args.KeyEnableDecryptOnly,
+		})
+		utils.HandleErr(err, "could not enable given key-id")
+
+		if err == nil {
+			console.Heading.Printf("Key '%s' %s:\n", color.WhiteString(*context.args.KeyIdEnable), resp.State)
+			printEntity(resp)
+		}
+	case "disable":
+		resp, err := context.pbClient.DisableKey(context.ctx, &pb.KeyStateRequest{
+			KeyId: *context.args.KeyIdDisable,
+		})
+		utils.HandleErr(err, "could not disable given key-id")
+
+		if err == nil {
+			console.Heading.Printf("Key '%s' %s:\n", color.WhiteString(*context.args.KeyIdDisable), resp.State)
+			printEntity(resp)
+		}
+	case "destroy":
+		if !*context.args.Force {
+			console.Fatalln("destroying a key is irreversible, files encrypted with it become unrecoverable. Issue --force to destroy")
+		}
+
+		resp, err := context.pbClient.DestroyKey(context.ctx, &pb.KeyStateRequest{
+			KeyId: *context.args.KeyIdDestroy,
+		})
+		utils.HandleErr(err, "could not destroy given key-id")
+
+		if err == nil {
+			console.Heading.Printf("Key '%s' %s:\n", color.WhiteString(*context.args.KeyIdDestroy), resp.State)
+			printEntity(resp)
+		}
+	case "show":
+		resp, err := context.pbClient.GetPublicKey(context.ctx, &pb.PublicKeyRequest{
+			KeyId:   *context.args.KeyIdShow,
+			Format:  *context.args.KeyShowFormat,
+			Version: *context.args.KeyShowVersion,
+		})
+		utils.HandleErr(err, "could not obtain public key for given key-id")
+
+		if err == nil {
+			console.Heading.Printf("== [%s] v%d ==\n", resp.KeyId, resp.Version)
+			console.Log.Println("- Algorithm: ", resp.Algorithm)
+			console.Log.Println("- Fingerprint (SHA-256): ", resp.Fingerprint)
+			console.Log.Printf("- Public Key (%s):\n", resp.Format)
+			console.Log.Println(strings.TrimSpace(string(resp.PublicKey)))
+		}
+	case "rotate":
+		stream, err := context.pbClient.RotateKey(context.streamCtx, &pb.KeyRotationRequest{
+			KeyId: *context.args.KeyIdRotate,
+		})
+		if err != nil {
+			utils.HandleErr(err, "could not rotate given key-id")
+			os.Exit(1)
+		}
+
+		// Print progress of each stored file
+		console.Heading.Printf("Rotating '%s':\n", color.WhiteString(*context.args.KeyIdRotate))
+		for {
+			progress, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				utils.HandleErr(err, "could not rotate given key-id")
+				os.Exit(1)
+			}
+
+			if len(progress.Error) > 0 {
+				console.Warning.Printf("[%d/%d] %s: %s\n", progress.Processed, progress.Total, progress.FilePath, progress.Error)
+			} else {
+				console.Log.Printf("[%d/%d] %s\n", progress.Processed, progress.Total, progress.FilePath)
+			}
+		}
+		console.Info.Printf("Successfuly rotated '%s'\n", *context.args.KeyIdRotate)
 	case "import":
 		// Read that gzip file
 		filePath := *context.args.KeyImportFilePath
 		if buffer, err := ioutil.ReadFile(filePath); err != nil {
 			utils.HandleErr(err, console.Info.Sprintf("failed to read file '%s'\n", filePath))
 		} else {
-			if _, err := context.pbClient.ImportKey(context.ctx, &pb.KeyImportRequest{
-				KeyId:   *context.args.KeyImportKeyId,
-				KeyGzip: buffer,
-				Force:   *context.args.Force,
+			// Packages other than gzip'd plaintext are encrypted using a passphrase
+			format := *context.args.KeyImportFormat
+			passphrase := *context.args.KeyImportPassphrase
+			if format == "package" && passphrase == "" && !bytes.HasPrefix(buffer, []byte{0x1f, 0x8b}) {
+				passphrase = promptLine("Passphrase: ")
+			}
+
+			mode := ""
+			if *context.args.KeyImportCascade && *context.args.KeyImportOrphan {
+				console.Fatalln("only one of --cascade or --orphan may be issued")
+			} else if *context.args.KeyImportCascade {
+				mode = "cascade"
+			} else if *context.args.KeyImportOrphan {
+				mode = "orphan"
+			}
+
+			if _, err := context.pbClient.ImportKey(context.streamCtx, &pb.KeyImportRequest{
+				KeyId:      *context.args.KeyImportKeyId,
+				KeyGzip:    buffer,
+				Force:      *context.args.Force,
+				Passphrase: passphrase,
+				Format:     format,
+				Mode:       mode,
 			}); err != nil {
 				utils.HandleErr(err, "request error")
 			} else {
 				console.Info.Printf("Successfuly imported '%s'\n", *context.args.KeyImportKeyId)
 			}
 		}
+	case "transfer":
+		handleKeysTransferSubCmd(actions[1:], context)
 	case "export":
-		if resp, err := context.pbClient.ExportKey(context.ctx, &pb.KeyExportRequest{
-			KeyId: *context.args.KeyExportKeyId,
+		passphrase := *context.args.KeyExportPassphrase
+		if passphrase == "" && !*context.args.KeyExportPlaintext {
+			passphrase = promptLine("Passphrase: ")
+			if passphrase != promptLine("Confirm Passphrase: ") {
+				console.Fatalln("Passphrases don't match")
+			}
+		}
+
+		if resp, err := context.pbClient.ExportKey(context.streamCtx, &pb.KeyExportRequest{
+			KeyId:             *context.args.KeyExportKeyId,
+			Passphrase:        passphrase,
+			InsecurePlaintext: *context.args.KeyExportPlaintext,
 		}); err != nil {
 			utils.HandleErr(err, "could not export key for given key-id")
 		} else {
@@ -156,6 +436,62 @@ func handleKeysSubCmd(actions []string, context *ClientContext) {
 	}
 }
 
+// Subcommand-Handler: Keys -> Transfer
+func handleKeysTransferSubCmd(actions []string, context *ClientContext) {
+	keyId := *context.args.KeyTransferKeyId
+	destKeyId := *context.args.KeyTransferDestKeyId
+	if destKeyId == "" {
+		destKeyId = keyId
+	}
+
+	// Connect to both servers
+	fromProfile, err := configuration.GetProfile(*context.args.KeyTransferFrom)
+	if err != nil {
+		console.Fatalln("Source server:", err)
+	}
+	toProfile, err := configuration.GetProfile(*context.args.KeyTransferTo)
+	if err != nil {
+		console.Fatalln("Destination server:", err)
+	}
+	fromConn, err := dialProfile(fromProfile)
+	if err != nil {
+		console.Fatalln("Could not connect to source server:", err)
+	}
+	defer fromConn.Close()
+	toConn, err := dialProfile(toProfile)
+	if err != nil {
+		console.Fatalln("Could not connect to destination server:", err)
+	}
+	defer toConn.Close()
+	fromClient, toClient := pb.NewOpenAbyssClient(fromConn), pb.NewOpenAbyssClient(toConn)
+
+	// Export the key sealed for the destination's transport key, only the destination
+	//  being able to import it
+	transportKey, err := toClient.GetTransportKey(context.streamCtx, &pb.EmptyMessage{})
+	if err != nil {
+		utils.HandleErr(err, "could not get destination server's transport key")
+		os.Exit(1)
+	}
+	exportResp, err := fromClient.ExportKey(context.streamCtx, &pb.KeyExportRequest{
+		KeyId:              keyId,
+		TransportPublicKey: transportKey.PublicKey,
+	})
+	if err != nil {
+		utils.HandleErr(err, "could not export key from source server")
+		os.Exit(1)
+	}
+	if _, err := toClient.ImportKey(context.streamCtx, &pb.KeyImportRequest{
+		KeyId:   destKeyId,
+		KeyGzip: exportResp.KeyGzip,
+		Force:   *context.args.Force,
+	}); err != nil {
+		utils.HandleErr(err, "could not import key into destination server")
+		os.Exit(1)
+	}
+
+	console.Info.Printf("Successfuly transferred '%s' from '%s' to '%s' as '%s'\n", keyId, *context.args.KeyTransferFrom, *context.args.KeyTransferTo, destKeyId)
+}
+
 // Subcommand-Handler: List Keys
 func handleListKeysSubCmd(actions []string, context *ClientContext) {
 	// List key names only
@@ -188,6 +524,27 @@ func handleListKeysSubCmd(actions []string, context *ClientContext) {
 	}
 }
 
+// Subcommand-Handler: List Key Algorithms
+func handleListAlgorithmsSubCmd(actions []string, context *ClientContext) {
+	resp, err := context.pbClient.GetKeyAlgorithms(context.ctx, &pb.EmptyMessage{})
+	utils.HandleErr(err, "could not get key algorithms")
+	if err == nil {
+		console.Heading.Println("Key Algorithms:")
+		for _, algorithm := range resp.Algorithms {
+			signing := ""
+			if algorithm.Signing {
+				signing = " (requires signed requests)"
+			}
+			console.Log.Printf("[%s]: %s%s\n", algorithm.Name, algorithm.Description, signing)
+		}
+
+		console.Heading.Println("Ciphers:")
+		for _, cipher := range resp.Ciphers {
+			console.Log.Printf("[%s]: %s\n", cipher.Name, cipher.Description)
+		}
+	}
+}
+
 // Subcommand-Handler: List Storage
 func handleListStorageSubCmd(actions []string, context *ClientContext) {
 	// Issue request & handle response
@@ -205,7 +562,12 @@ func handleListStorageSubCmd(actions []string, context *ClientContext) {
 				createdDate := time.Unix(int64(entry.CreatedUnixTimestamp), 0).Format(time.RFC822)
 				modifiedDate := time.Unix(int64(entry.ModifiedUnixTimestamp), 0).Format(time.RFC822)
 
-				console.Log.Printf("[%s]: Created at '%s' | Last Modified at '%s'\n", entry.Path, createdDate, modifiedDate)
+				keyInfo := "Key unknown"
+				if entry.KeyName != "" {
+					keyInfo = fmt.Sprintf("Key '%s' v%d", entry.KeyName, entry.KeyVersion)
+				}
+
+				console.Log.Printf("[%s]: Created at '%s' | Last Modified at '%s' | %s\n", entry.Path, createdDate, modifiedDate, keyInfo)
 			}
 		} else {
 			console.Warning.Println("No internal content")
@@ -226,52 +588,100 @@ func handleEncryptSubCmd(actions []string, context *ClientContext) {
 		if len(*context.args.EncryptCertPath) > 0 {
 			if certFile, err := ioutil.ReadFile(*context.args.EncryptCertPath); err != nil {
 				console.Error.Println("Failed to read Certificate:", err)
-			} else {
-				// Create ed25519 key from pem file
-				sk = utils.PEM_to_ed25519_sk(certFile)
+			} else if sk, err = utils.ParseSigningKey(certFile); err != nil {
+				// Create ed25519 key from the pem, openssh or jwk file
+				console.Error.Println("Failed to parse Certificate:", err)
 			}
 		}
 
-		// Read in the file to Encrypt
-		if fileBytes, err := ioutil.ReadFile(*context.args.EncryptFile); err != nil {
+		// Open the file to Encrypt
+		file, err := os.Open(*context.args.EncryptFile)
+		if err != nil {
 			console.Fatalln("could not read in file:", err)
-		} else {
-			// Compress given data
-			compBuffer := bytes.NewBuffer(nil)
-			writer := gzip.NewWriter(compBuffer)
-			writer.Write(fileBytes)
-			writer.Close()
-
-			// Sign the file bytes if signing key is present
-			var file_sig []byte
-			if sk != nil {
-				file_sig = ed25519.Sign(sk, compBuffer.Bytes())
+		}
+		defer file.Close()
+		fileStat, err := file.Stat()
+		if err != nil {
+			console.Fatalln("could not read in file:", err)
+		}
+
+		// Open stream & send header
+		stream, err := context.pbClient.EncryptFileStream(context.streamCtx)
+		if err != nil {
+			utils.HandleErr(err, "failed to encrypt file")
+			os.Exit(1)
+		}
+		stream.Send(&pb.FileStreamPacket{
+			Packet: &pb.FileStreamPacket_Header{
+				Header: &pb.FileStreamHeader{
+					SizeInBytes: fileStat.Size(),
+					FileName:    path.Base(*context.args.EncryptFile),
+					Options: &pb.FileOptions{
+						StoragePath: *context.args.StoragePath,
+						KeyName:     *context.args.EncryptKeyId,
+						Overwrite:   *context.args.Force,
+						Compressed:  true,
+					},
+				},
+			},
+		})
+
+		// Compress given data while streaming it
+		pipeReader, pipeWriter := io.Pipe()
+		go func() {
+			writer := gzip.NewWriter(pipeWriter)
+			_, err := io.Copy(writer, file)
+			if err == nil {
+				err = writer.Close()
+			}
+			pipeWriter.CloseWithError(err)
+		}()
+
+		// Stream compressed chunks, keeping track of their digest for signing
+		digest := sha256.New()
+		chunk := make([]byte, fileChunkSize)
+		for {
+			n, err := io.ReadFull(pipeReader, chunk)
+			if n > 0 {
+				digest.Write(chunk[:n])
+
+				// Server closed the stream, error is obtained on close
+				if err := stream.Send(&pb.FileStreamPacket{
+					Packet: &pb.FileStreamPacket_Chunk{Chunk: chunk[:n]},
+				}); err != nil {
+					break
+				}
+			}
+
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			} else if err != nil {
+				console.Fatalln("could not read in file:", err)
 			}
+		}
 
-			resp, err := context.pbClient.EncryptFile(context.ctx, &pb.FilePacket{
-				FileBytes:     compBuffer.Bytes(),
-				FileSignature: file_sig,
-				SizeInBytes:   int64(len(fileBytes)),
-				FileName:      path.Base(*context.args.EncryptFile),
-				Options: &pb.FileOptions{
-					StoragePath: *context.args.StoragePath,
-					KeyName:     *context.args.EncryptKeyId,
-					Overwrite:   *context.args.Force,
+		// Sign the streamed data's digest if signing key is present
+		if sk != nil {
+			stream.Send(&pb.FileStreamPacket{
+				Packet: &pb.FileStreamPacket_FileSignature{
+					FileSignature: ed25519.Sign(sk, digest.Sum(nil)),
 				},
 			})
-			if err != nil {
-				// Handle duplicate internal store file found
-				isDuplicate := regexp.MustCompile("(?i)duplicte").MatchString(err.Error())
-				if isDuplicate {
-					console.Warning.Println("Duplicate stored file found. Use --force to overwrite")
-				} else {
-					utils.HandleErr(err, "failed to encrypt file")
-				}
+		}
 
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			// Handle duplicate internal store file found
+			isDuplicate := regexp.MustCompile("(?i)duplicte").MatchString(err.Error())
+			if isDuplicate {
+				console.Warning.Println("Duplicate stored file found. Use --force to overwrite")
 			} else {
-				storedFilePath := path.Join(resp.FileStoragePath, resp.FileId)
-				console.Info.Printf("Encrypted '%s' -> '%s' successfuly!\n", *context.args.EncryptFile, storedFilePath)
+				utils.HandleErr(err, "failed to encrypt file")
 			}
+
+		} else {
+			storedFilePath := path.Join(resp.FileStoragePath, resp.FileId)
+			console.Info.Printf("Encrypted '%s' -> '%s' successfuly!\n", *context.args.EncryptFile, storedFilePath)
 		}
 	}
 }
@@ -284,48 +694,87 @@ func handleDecryptSubCmd(actions []string, context *ClientContext) {
 	if len(*context.args.DecryptCertPath) > 0 {
 		if certFile, err := ioutil.ReadFile(*context.args.DecryptCertPath); err != nil {
 			console.Error.Println("Failed to read Certificate:", err)
+		} else if sk, err := utils.ParseSigningKey(certFile); err != nil {
+			console.Error.Println("Failed to parse Certificate:", err)
 		} else {
-			// Create ed25519 key from pem file
-			sk := utils.PEM_to_ed25519_sk(certFile)
+			// Sign using the ed25519 key of the pem, openssh or jwk file
 			filePathSig = ed25519.Sign(sk, []byte(*context.args.DecryptFile))
 		}
 	}
 
 	// Issue request
-	resp, err := context.pbClient.DecryptFile(context.ctx, &pb.DecryptRequest{
+	stream, err := context.pbClient.DecryptFileStream(context.streamCtx, &pb.DecryptRequest{
 		FilePath:          *context.args.DecryptFile,
 		FilePathSignature: filePathSig,
 		KeyName:           []byte(*context.args.DecryptKeyId),
 	})
+	if err != nil {
+		utils.HandleErr(err, "could no decrypt file")
+		os.Exit(1)
+	}
 
-	// Handle response
+	// First packet is expected to be the header
+	packet, err := stream.Recv()
 	if err != nil {
 		utils.HandleErr(err, "could no decrypt file")
-	} else {
-		fileBuffer := make([]byte, resp.SizeInBytes)
-		gReader, err := gzip.NewReader(bytes.NewBuffer(resp.FileBytes))
-		if err != nil {
+		os.Exit(1)
+	}
+	header := packet.GetHeader()
+	if header == nil {
+		console.Fatalln("could no decrypt file: no file stream header received")
+	}
+
+	// Pipe received chunks into decompression
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		for {
+			packet, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				pipeWriter.CloseWithError(err)
+				return
+			}
+			if _, err := pipeWriter.Write(packet.GetChunk()); err != nil {
+				return
+			}
+		}
+	}()
+
+	// Decompress data if it was stored compressed
+	var gReader io.Reader = pipeReader
+	if header.Options.GetCompressed() {
+		if gReader, err = gzip.NewReader(pipeReader); err != nil {
 			utils.HandleErr(err, "gzip failed to extract data")
 			os.Exit(1)
 		}
-		gReader.Read(fileBuffer)
+	}
 
-		// Output to a file
-		if len(*context.args.FilePacketOutput) > 0 {
-			console.Log.Printf("File Name: %s\n", resp.FileName)
-			console.Log.Printf("File Size in Bytes: %d Bytes\n", resp.SizeInBytes)
+	// Output to a file
+	if len(*context.args.FilePacketOutput) > 0 {
+		console.Log.Printf("File Name: %s\n", header.FileName)
+		console.Log.Printf("File Size in Bytes: %d Bytes\n", header.SizeInBytes)
 
-			if fd, err := os.Create(*context.args.FilePacketOutput); err != nil {
-				utils.HandleErr(err, "failed to create file")
-			} else {
-				fd.Write(fileBuffer)
-				fd.Close()
+		if fd, err := os.Create(*context.args.FilePacketOutput); err != nil {
+			utils.HandleErr(err, "failed to create file")
+		} else {
+			_, err := io.Copy(fd, gReader)
+			fd.Close()
 
-				console.Log.Println("Data saved to:", *context.args.FilePacketOutput)
+			// Discard partially decrypted data
+			if err != nil {
+				os.Remove(*context.args.FilePacketOutput)
+				utils.HandleErr(err, "could no decrypt file")
+				os.Exit(1)
 			}
+			console.Log.Println("Data saved to:", *context.args.FilePacketOutput)
+		}
 
-		} else { // Output to stdout
-			console.Log.Print(string(fileBuffer))
+	} else { // Output to stdout
+		if _, err := io.Copy(os.Stdout, gReader); err != nil {
+			utils.HandleErr(err, "could no decrypt file")
+			os.Exit(1)
 		}
 	}
 }
@@ -520,6 +969,85 @@ func handleBackupSubCmd(actions []string, context *ClientContext) {
 	}
 }
 
+// Helper function that prompts for a line read from stdin
+func promptLine(prompt string) string {
+	console.Info.Print(prompt)
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		console.Fatalln("Failed to read from stdin:", err)
+	}
+	return strings.TrimSpace(line)
+}
+
+// Helper function that prints the server's seal status
+func printSealStatus(sealStatus *pb.SealStatus) {
+	if sealStatus.Sealed {
+		console.Log.Println("- Sealed:", color.HiRedString("true"))
+	} else {
+		console.Log.Println("- Sealed:", color.HiGreenString("false"))
+	}
+	console.Log.Printf("- Initialized: %v\n", sealStatus.Initialized)
+	if sealStatus.Initialized {
+		console.Log.Printf("- Threshold: %d of %d shares\n", sealStatus.Threshold, sealStatus.Shares)
+		if sealStatus.Sealed {
+			console.Log.Printf("- Unseal Progress: %d/%d\n", sealStatus.Progress, sealStatus.Threshold)
+		}
+	}
+}
+
+// Subcommand-Handler: Operator
+func handleOperatorSubCmd(actions []string, context *ClientContext) {
+	switch actions[0] {
+	case "init":
+		resp, err := context.pbClient.Init(context.ctx, &pb.InitRequest{
+			Shares:    *context.args.OperatorShares,
+			Threshold: *context.args.OperatorThreshold,
+		})
+		if err != nil {
+			utils.HandleErr(err, "could not initialize server")
+			os.Exit(1)
+		}
+
+		console.Heading.Printf("Master key split into %d unseal shares:\n", len(resp.Shares))
+		for idx, share := range resp.Shares {
+			console.Log.Printf("Unseal Share %d: %s\n", idx+1, share)
+		}
+		console.Warning.Printf("Distribute the shares to operators, %d of them are required to unseal the server. Shares are never shown again!\n", resp.Threshold)
+	case "unseal":
+		share := *context.args.OperatorUnsealShare
+		if share == "" && !*context.args.OperatorUnsealReset {
+			share = promptLine("Unseal Share: ")
+		}
+
+		resp, err := context.pbClient.Unseal(context.streamCtx, &pb.UnsealRequest{
+			Share:         share,
+			ResetProgress: *context.args.OperatorUnsealReset,
+		})
+		if err != nil {
+			utils.HandleErr(err, "could not unseal server")
+			os.Exit(1)
+		}
+		console.Heading.Println("Seal Status:")
+		printSealStatus(resp)
+	case "seal":
+		resp, err := context.pbClient.Seal(context.ctx, &pb.EmptyMessage{})
+		if err != nil {
+			utils.HandleErr(err, "could not seal server")
+			os.Exit(1)
+		}
+		console.Heading.Println("Seal Status:")
+		printSealStatus(resp)
+	case "status":
+		resp, err := context.pbClient.GetSealStatus(context.ctx, &pb.EmptyMessage{})
+		if err != nil {
+			utils.HandleErr(err, "could not get seal status")
+			os.Exit(1)
+		}
+		console.Heading.Println("Seal Status:")
+		printSealStatus(resp)
+	}
+}
+
 // Subcommand-Handler: Version
 func handleVersionSubCmnd(actions []string, context *ClientContext) {
 	// Get current config
@@ -533,24 +1061,9 @@ func handleVersionSubCmnd(actions []string, context *ClientContext) {
 	console.Heading.Printf("Client Version: %s\n", color.HiWhiteString(version))
 }
 
-func main() {
-	// Init: Parse Arguments
-	rawSubCmd, args := ParseArguments()
-	subCmds := strings.Split(rawSubCmd, " ")
-	directSubCmd, actions := subCmds[0], subCmds[1:]
-
-	if *args.Verbose {
-		configuration.EnableVerbose()
-	}
-
-	configuration.Init() // Client Config
-	console.Init()
-
-	grpcHost := configuration.LoadedConfig.GrpcHost
-	grpcPort := configuration.LoadedConfig.GrpcPort
-	address := fmt.Sprintf("%s:%d", grpcHost, grpcPort)
-	var conn *grpc.ClientConn
-	var conn_err error = nil
+// Dials the server of the given profile
+func dialProfile(profile configuration.Profile) (*grpc.ClientConn, error) {
+	address := fmt.Sprintf("%s:%d", profile.GrpcHost, profile.GrpcPort)
 
 	// Get the current binary's parent directory path which will be used for
 	// determining where the certificates are. (Client-Only)
@@ -561,26 +1074,39 @@ func main() {
 	}
 
 	// Init TLS Credentials
-	insecure := configuration.LoadedConfig.Insecure
-	tlsCertPath := path.Join(wd, configuration.LoadedConfig.TLSCertPath)
-
-	if !insecure {
+	if !profile.Insecure {
+		tlsCertPath := path.Join(wd, profile.TLSCertPath)
 		creds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
 		if err != nil {
 			console.Fatalln("tls could not be read from file:", err)
 		}
 
-		conn, conn_err = grpc.Dial(
+		return grpc.Dial(
 			address,
 			grpc.WithTransportCredentials(creds),
 		)
-	} else {
-		conn, conn_err = grpc.Dial(
-			address,
-			grpc.WithInsecure(),
-		)
 	}
+	return grpc.Dial(
+		address,
+		grpc.WithInsecure(),
+	)
+}
+
+func main() {
+	// Init: Parse Arguments
+	rawSubCmd, args := ParseArguments()
+	subCmds := strings.Split(rawSubCmd, " ")
+	directSubCmd, actions := subCmds[0], subCmds[1:]
+
+	if *args.Verbose {
+		configuration.EnableVerbose()
+	}
+
+	configuration.Init() // Client Config
+	console.Init()
 
+	profile, _ := configuration.GetProfile("default")
+	conn, conn_err := dialProfile(profile)
 	if conn_err != nil {
 		log.Fatalf("did not connect: %v", conn_err)
 	}
@@ -589,12 +1115,15 @@ func main() {
 	client := pb.NewOpenAbyssClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
+	streamCtx, streamCancel := context.WithCancel(context.Background())
+	defer streamCancel()
 
 	// Construct Context
 	context := ClientContext{
-		pbClient: client,
-		ctx:      ctx,
-		args:     args,
+		pbClient:  client,
+		ctx:       ctx,
+		streamCtx: streamCtx,
+		args:      args,
 	}
 
 	// Client Reqeust
@@ -604,6 +1133,8 @@ func main() {
 			handleListKeysSubCmd(actions[1:], &context)
 		} else if actions[0] == "storage" {
 			handleListStorageSubCmd(actions[1:], &context)
+		} else if actions[0] == "algorithms" {
+			handleListAlgorithmsSubCmd(actions[1:], &context)
 		}
 	case "keys":
 		handleKeysSubCmd(actions, &context)
@@ -615,6 +1146,8 @@ func main() {
 		handleRemoveSubCmd(actions, &context)
 	case "backup":
 		handleBackupSubCmd(actions, &context)
+	case "operator":
+		handleOperatorSubCmd(actions, &context)
 	case "version":
 		handleVersionSubCmnd(actions, &context)
 	}
@@ -0,0 +1,214 @@
+package entity
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Supported symmetric cipher algorithms
+const (
+	CipherAES_CFB            = "aes"               // Legacy unauthenticated AES-CFB
+	CipherAES_GCM            = "aes-gcm"           // Authenticated AES-256-GCM, encrypted in chunks
+	CipherXChaCha20_Poly1305 = "xchacha20poly1305" // Authenticated XChaCha20-Poly1305, encrypted in chunks
+)
+
+// Size in bytes of plaintext sealed per chunk
+const AEADChunkSize = 64 * 1024
+
+var (
+	DefaultCipherAlgorithm = CipherAES_GCM
+
+	// Returned when encrypted data fails authentication
+	ErrIntegrity = errors.New("integrity check failed, encrypted data was tampered with or corrupted")
+)
+
+// Size of the random nonce prefix, leaving room for the chunk counter and the
+//  last chunk flag
+func aeadNoncePrefixSize(aead cipher.AEAD) int {
+	return aead.NonceSize() - 5
+}
+
+// Constructs the chunk's nonce: prefix | counter (big endian) | last chunk flag
+func aeadChunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, len(prefix)+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[len(prefix):], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// aeadCipherAlgorithm encrypts blob data in authenticated chunks using the AEAD
+//  created from the data key
+type aeadCipherAlgorithm struct {
+	name        string
+	description string
+	blobId      uint8
+	nonceSize   int // Nonce size of the AEAD, of which the prefix is stored
+	newAEAD     func(key []byte) (cipher.AEAD, error)
+}
+
+func (algorithm aeadCipherAlgorithm) Name() string {
+	return algorithm.name
+}
+
+func (algorithm aeadCipherAlgorithm) Description() string {
+	return algorithm.description
+}
+
+func (algorithm aeadCipherAlgorithm) BlobId() uint8 {
+	return algorithm.blobId
+}
+
+func (aeadCipherAlgorithm) Authenticated() bool {
+	return true
+}
+
+func (algorithm aeadCipherAlgorithm) NonceSize() int {
+	return algorithm.nonceSize - 5
+}
+
+func (algorithm aeadCipherAlgorithm) NewEncryptWriter(destWriter io.WriteCloser, key []byte, nonce []byte, additionalData []byte) (io.WriteCloser, error) {
+	aead, err := algorithm.newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aeadNoncePrefixSize(aead) {
+		return nil, errors.New("invalid nonce size")
+	}
+	return newAEADStreamWriter(destWriter, aead, nonce, additionalData), nil
+}
+
+func (algorithm aeadCipherAlgorithm) NewDecryptReader(srcReader io.Reader, key []byte, nonce []byte, additionalData []byte) (io.Reader, error) {
+	aead, err := algorithm.newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aeadNoncePrefixSize(aead) {
+		return nil, ErrIntegrity
+	}
+	return newAEADStreamReader(srcReader, aead, nonce, additionalData), nil
+}
+
+// aeadStreamWriter seals written data in fixed-size chunks
+type aeadStreamWriter struct {
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buffer  []byte
+	dest    io.WriteCloser
+	ad      []byte // Additional data authenticated with every chunk
+}
+
+// Creates a stream writer sealing chunks into given destination, using the nonce
+//  prefix and additional data
+func newAEADStreamWriter(destWriter io.WriteCloser, aead cipher.AEAD, prefix []byte, additionalData []byte) *aeadStreamWriter {
+	return &aeadStreamWriter{
+		aead:   aead,
+		prefix: prefix,
+		buffer: make([]byte, 0, AEADChunkSize),
+		dest:   destWriter,
+		ad:     additionalData,
+	}
+}
+
+func (w *aeadStreamWriter) sealChunk(last bool) error {
+	if w.counter == ^uint32(0) {
+		return errors.New("too many chunks to encrypt")
+	}
+
+	sealed := w.aead.Seal(nil, aeadChunkNonce(w.prefix, w.counter, last), w.buffer, w.ad)
+	w.counter++
+	w.buffer = w.buffer[:0]
+
+	_, err := w.dest.Write(sealed)
+	return err
+}
+
+func (w *aeadStreamWriter) Write(p []byte) (int, error) {
+	total := len(p)
+	for len(p) > 0 {
+		// Seal full chunks only once more data arrives, the last chunk is
+		//  sealed on close
+		if len(w.buffer) == AEADChunkSize {
+			if err := w.sealChunk(false); err != nil {
+				return total - len(p), err
+			}
+		}
+
+		n := copy(w.buffer[len(w.buffer):AEADChunkSize], p)
+		w.buffer = w.buffer[:len(w.buffer)+n]
+		p = p[n:]
+	}
+	return total, nil
+}
+
+func (w *aeadStreamWriter) Close() error {
+	if err := w.sealChunk(true); err != nil {
+		return err
+	}
+	return w.dest.Close()
+}
+
+// aeadStreamReader opens sealed chunks as they're read
+type aeadStreamReader struct {
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	source  *bufio.Reader
+	sealed  []byte
+	plain   []byte
+	done    bool
+	ad      []byte // Additional data authenticated with every chunk
+}
+
+// Creates a stream reader opening chunks read from given source, using the nonce
+//  prefix and additional data
+func newAEADStreamReader(srcReader io.Reader, aead cipher.AEAD, prefix []byte, additionalData []byte) *aeadStreamReader {
+	source, ok := srcReader.(*bufio.Reader)
+	if !ok {
+		source = bufio.NewReader(srcReader)
+	}
+
+	return &aeadStreamReader{
+		aead:   aead,
+		prefix: prefix,
+		source: source,
+		sealed: make([]byte, AEADChunkSize+aead.Overhead()),
+		ad:     additionalData,
+	}
+}
+
+func (r *aeadStreamReader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		// Read the next sealed chunk, which is the last if no data follows it
+		n, err := io.ReadFull(r.source, r.sealed)
+		if err == io.EOF || (err == nil && n == 0) {
+			return 0, ErrIntegrity // Truncated before the last chunk
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		_, peekErr := r.source.Peek(1)
+		last := err == io.ErrUnexpectedEOF || peekErr == io.EOF
+
+		plain, openErr := r.aead.Open(r.sealed[:0], aeadChunkNonce(r.prefix, r.counter, last), r.sealed[:n], r.ad)
+		if openErr != nil {
+			return 0, ErrIntegrity
+		}
+		r.counter++
+		r.plain = plain
+		r.done = last
+	}
+
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
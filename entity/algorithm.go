@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+)
+
+// KeyMaterial holds the key material of a key's version, as persisted along with
+//  the version
+type KeyMaterial struct {
+	StoreName     string // Key store name of the version, holding keypairs stored as files
+	CipherEncKey  string // Base64 cipher key, encrypted by keys holding keypair files
+	PrivateKeyPem string // Private key of keys holding their keypair within the version
+	PublicKeyPem  string // Public key of keys holding their keypair within the version
+	KeySize       int    // Key size in bits, for algorithms supporting multiple sizes
+	OAEPHash      string // RSA-OAEP hash, for algorithms using RSA-OAEP
+}
+
+// KeyPair holds the PEM encoded keypair of a key's version stored as files within
+//  the key store, as exported along with the key
+type KeyPair struct {
+	PrivateKeyPem []byte
+	PublicKeyPem  []byte
+}
+
+// SigningKey holds the signing keypair generated for keys requiring clients to
+//  sign their requests. The private key seed is only ever handed to the client.
+type SigningKey struct {
+	PublicKeyPem   []byte
+	PrivateKeySeed []byte
+}
+
+// KeyAlgorithm implements the generation & usage of a key algorithm's material,
+//  registered by name using RegisterKeyAlgorithm
+type KeyAlgorithm interface {
+	// Unique name of the algorithm, stored along with keys
+	Name() string
+
+	// Short human readable description of the algorithm
+	Description() string
+
+	// Generates the key material of a key's version, given the version's store
+	//  name & requested parameters. Parameters the algorithm doesn't use are cleared.
+	Generate(material *KeyMaterial) error
+
+	// Loads & validates the version's key material is usable, filling in
+	//  parameters unknown to keys generated prior to them being selectable
+	Load(material *KeyMaterial) error
+
+	// Wraps/Unwraps per-file data keys
+	WrapDataKey(material *KeyMaterial, dataKey []byte) ([]byte, error)
+	UnwrapDataKey(material *KeyMaterial, wrappedKey []byte) ([]byte, error)
+
+	// AES cipher key of the version, used by files stored prior to per-file
+	//  data keys
+	CipherKey(material *KeyMaterial) ([]byte, error)
+
+	// PEM encoded public key of the version, empty if the algorithm has none
+	PublicKeyPem(material *KeyMaterial) []byte
+
+	// Exports the version's keypair stored as files within the key store, nil for
+	//  algorithms holding their key material within the version
+	ExportKeyPair(material *KeyMaterial) (*KeyPair, error)
+
+	// Validates the exported keypair matches the version's key material, without
+	//  storing it
+	ValidateKeyPair(material *KeyMaterial, keyPair *KeyPair) error
+
+	// Stores the validated exported keypair as files within the key store, under
+	//  the version's store name
+	ImportKeyPair(material *KeyMaterial, keyPair *KeyPair) error
+
+	// Whether clients are required to sign their requests using the key's
+	//  signing keypair
+	CanSign() bool
+	GenerateSigningKey() (*SigningKey, error)
+	Verify(publicKeyPem []byte, data []byte, signature []byte) bool
+}
+
+// Registered key algorithms by name
+var keyAlgorithms = map[string]KeyAlgorithm{}
+
+// Registers the key algorithm, panics if the name is already registered
+func RegisterKeyAlgorithm(algorithm KeyAlgorithm) {
+	if _, ok := keyAlgorithms[algorithm.Name()]; ok {
+		panic("key algorithm '" + algorithm.Name() + "' already registered")
+	}
+	keyAlgorithms[algorithm.Name()] = algorithm
+}
+
+// Returns the registered key algorithm by name
+func GetKeyAlgorithm(name string) (KeyAlgorithm, error) {
+	if algorithm, ok := keyAlgorithms[name]; ok {
+		return algorithm, nil
+	}
+	return nil, errors.New("key algorithm '" + name + "' not supported")
+}
+
+// Returns all registered key algorithms, ordered by name
+func KeyAlgorithms() []KeyAlgorithm {
+	algorithms := make([]KeyAlgorithm, 0, len(keyAlgorithms))
+	for _, algorithm := range keyAlgorithms {
+		algorithms = append(algorithms, algorithm)
+	}
+	sort.Slice(algorithms, func(i, j int) bool {
+		return algorithms[i].Name() < algorithms[j].Name()
+	})
+	return algorithms
+}
+
+// Embedded by key algorithms not requiring signed requests
+type noSigning struct{}
+
+func (noSigning) CanSign() bool {
+	return false
+}
+
+func (noSigning) GenerateSigningKey() (*SigningKey, error) {
+	return nil, errors.New("key algorithm does not sign")
+}
+
+func (noSigning) Verify(publicKeyPem []byte, data []byte, signature []byte) bool {
+	return false
+}
+
+// Embedded by key algorithms wrapping data keys to a public key, holding no
+//  cipher key
+type noCipherKey struct{}
+
+func (noCipherKey) CipherKey(material *KeyMaterial) ([]byte, error) {
+	return nil, errors.New("key algorithm has no cipher key")
+}
+
+// Embedded by key algorithms holding their key material within the version, storing
+//  no keypair files
+type noKeyPairFiles struct{}
+
+func (noKeyPairFiles) ExportKeyPair(material *KeyMaterial) (*KeyPair, error) {
+	return nil, nil
+}
+
+func (noKeyPairFiles) ValidateKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	if keyPair != nil {
+		return errors.New("key algorithm holds no keypair files")
+	}
+	return nil
+}
+
+func (noKeyPairFiles) ImportKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	return nil
+}
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+)
+
+// AES keys wrap data keys using the key's un-encrypted stored cipher key. Internal
+//  file storage is encrypted, even though the key itself is not encrypted. That is
+//  due to trusting the autority OF storing said data.
+type aesKeyAlgorithm struct {
+	noSigning
+	noKeyPairFiles
+}
+
+// Ed25519 keys are AES keys requiring clients to sign their requests
+type ed25519KeyAlgorithm struct {
+	aesKeyAlgorithm
+}
+
+func init() {
+	RegisterKeyAlgorithm(aesKeyAlgorithm{})
+	RegisterKeyAlgorithm(ed25519KeyAlgorithm{})
+}
+
+func (aesKeyAlgorithm) Name() string {
+	return "none"
+}
+
+func (aesKeyAlgorithm) Description() string {
+	return "Data keys wrapped using the key's stored AES key"
+}
+
+func (aesKeyAlgorithm) Generate(material *KeyMaterial) error {
+	aesKey, err := GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	material.CipherEncKey = base64.StdEncoding.EncodeToString(aesKey)
+	material.KeySize = 0
+	material.OAEPHash = ""
+	return nil
+}
+
+func (algorithm aesKeyAlgorithm) Load(material *KeyMaterial) error {
+	_, err := algorithm.cipherBlock(material)
+	return err
+}
+
+func (algorithm aesKeyAlgorithm) WrapDataKey(material *KeyMaterial, dataKey []byte) ([]byte, error) {
+	c, err := algorithm.cipherBlock(material)
+	if err != nil {
+		return nil, err
+	}
+	return CipherSeal(c, dataKey)
+}
+
+func (algorithm aesKeyAlgorithm) UnwrapDataKey(material *KeyMaterial, wrappedKey []byte) ([]byte, error) {
+	c, err := algorithm.cipherBlock(material)
+	if err != nil {
+		return nil, err
+	}
+	return CipherOpen(c, wrappedKey)
+}
+
+// Decodes the un-encrypted stored cipher key
+func (aesKeyAlgorithm) CipherKey(material *KeyMaterial) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(material.CipherEncKey)
+}
+
+// Creates the cipher block from the un-encrypted stored cipher key
+func (algorithm aesKeyAlgorithm) cipherBlock(material *KeyMaterial) (cipher.Block, error) {
+	cipherKey, err := algorithm.CipherKey(material)
+	if err != nil {
+		return nil, err
+	}
+	return aes.NewCipher(cipherKey)
+}
+
+func (aesKeyAlgorithm) PublicKeyPem(material *KeyMaterial) []byte {
+	return nil
+}
+
+func (ed25519KeyAlgorithm) Name() string {
+	return "ed25519"
+}
+
+func (ed25519KeyAlgorithm) Description() string {
+	return "Data keys wrapped using the key's stored AES key, requests signed by the client"
+}
+
+func (ed25519KeyAlgorithm) CanSign() bool {
+	return true
+}
+
+// Generates the Public/Private signing keys. Private key goes to the user, public
+//  key goes to both
+func (ed25519KeyAlgorithm) GenerateSigningKey() (*SigningKey, error) {
+	pk, sk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	pkDer, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{
+		PublicKeyPem:   pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkDer}),
+		PrivateKeySeed: sk.Seed(),
+	}, nil
+}
+
+func (ed25519KeyAlgorithm) Verify(publicKeyPem []byte, data []byte, signature []byte) bool {
+	pk, err := parseEd25519PublicKey(publicKeyPem)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(pk, data, signature)
+}
+
+// Parses the PKIX PEM encoded ed25519 public key
+func parseEd25519PublicKey(publicKeyPem []byte) (ed25519.PublicKey, error) {
+	decodedKey, _ := pem.Decode(publicKeyPem)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(decodedKey.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	pk, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("key is not an ed25519 key")
+	}
+	return pk, nil
+}
@@ -0,0 +1,168 @@
+package entity
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ECDH keys wrap data keys to the public key of the named curve, holding their
+//  keypair within the key's versions
+type ecdhKeyAlgorithm struct {
+	noSigning
+	noCipherKey
+	noKeyPairFiles
+	curve string
+}
+
+// Hybrid keys wrap data keys to both an ML-KEM-768 & an X25519 public key, holding
+//  their keypair within the key's versions
+type hybridKeyAlgorithm struct {
+	noSigning
+	noCipherKey
+	noKeyPairFiles
+}
+
+func init() {
+	RegisterKeyAlgorithm(ecdhKeyAlgorithm{curve: ECDH_X25519})
+	RegisterKeyAlgorithm(ecdhKeyAlgorithm{curve: ECDH_P256})
+	RegisterKeyAlgorithm(hybridKeyAlgorithm{})
+}
+
+func (algorithm ecdhKeyAlgorithm) Name() string {
+	return algorithm.curve
+}
+
+func (ecdhKeyAlgorithm) Description() string {
+	return "Data keys wrapped using ECDH, HKDF-SHA256 & AES-GCM"
+}
+
+func (algorithm ecdhKeyAlgorithm) Generate(material *KeyMaterial) error {
+	sk, err := GenerateECDHKey(algorithm.curve)
+	if err != nil {
+		return err
+	}
+	skPem, err := MarshalECDHPrivateKey(sk)
+	if err != nil {
+		return err
+	}
+	pkPem, err := MarshalECDHPublicKey(sk.PublicKey())
+	if err != nil {
+		return err
+	}
+
+	// Data keys are wrapped to the public key, requiring no cipher key
+	material.CipherEncKey = ""
+	material.PrivateKeyPem = string(skPem)
+	material.PublicKeyPem = string(pkPem)
+	material.KeySize = 0
+	material.OAEPHash = ""
+	return nil
+}
+
+func (algorithm ecdhKeyAlgorithm) Load(material *KeyMaterial) error {
+	_, err := ParseECDHPrivateKey(algorithm.curve, []byte(material.PrivateKeyPem))
+	return err
+}
+
+// Validates the version's public key matches its private key, holding no keypair files
+func (algorithm ecdhKeyAlgorithm) ValidateKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	if keyPair != nil {
+		return errors.New("key algorithm holds no keypair files")
+	}
+	sk, err := ParseECDHPrivateKey(algorithm.curve, []byte(material.PrivateKeyPem))
+	if err != nil {
+		return err
+	}
+	pk, err := ParseECDHPublicKey(algorithm.curve, []byte(material.PublicKeyPem))
+	if err != nil {
+		return err
+	}
+	if !sk.PublicKey().Equal(pk) {
+		return errors.New("public key doesn't match the private key")
+	}
+	return nil
+}
+
+func (algorithm ecdhKeyAlgorithm) WrapDataKey(material *KeyMaterial, dataKey []byte) ([]byte, error) {
+	sk, err := ParseECDHPrivateKey(algorithm.curve, []byte(material.PrivateKeyPem))
+	if err != nil {
+		return nil, err
+	}
+	return ECDHWrapDataKey(sk.PublicKey(), dataKey)
+}
+
+func (algorithm ecdhKeyAlgorithm) UnwrapDataKey(material *KeyMaterial, wrappedKey []byte) ([]byte, error) {
+	sk, err := ParseECDHPrivateKey(algorithm.curve, []byte(material.PrivateKeyPem))
+	if err != nil {
+		return nil, err
+	}
+	return ECDHUnwrapDataKey(sk, wrappedKey)
+}
+
+func (ecdhKeyAlgorithm) PublicKeyPem(material *KeyMaterial) []byte {
+	return []byte(material.PublicKeyPem)
+}
+
+func (hybridKeyAlgorithm) Name() string {
+	return KEM_MLKEM768_X25519
+}
+
+func (hybridKeyAlgorithm) Description() string {
+	return "Post-quantum hybrid, data keys wrapped using ML-KEM-768 & X25519, HKDF-SHA256 & AES-GCM"
+}
+
+func (hybridKeyAlgorithm) Generate(material *KeyMaterial) error {
+	sk, err := GenerateHybridKey()
+	if err != nil {
+		return err
+	}
+
+	// Data keys are wrapped to the public key, requiring no cipher key
+	material.CipherEncKey = ""
+	material.PrivateKeyPem = string(MarshalHybridPrivateKey(sk))
+	material.PublicKeyPem = string(MarshalHybridPublicKey(sk.PublicKey()))
+	material.KeySize = 0
+	material.OAEPHash = ""
+	return nil
+}
+
+func (hybridKeyAlgorithm) Load(material *KeyMaterial) error {
+	_, err := ParseHybridPrivateKey([]byte(material.PrivateKeyPem))
+	return err
+}
+
+// Validates the version's public key matches its private key, holding no keypair files
+func (hybridKeyAlgorithm) ValidateKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	if keyPair != nil {
+		return errors.New("key algorithm holds no keypair files")
+	}
+	sk, err := ParseHybridPrivateKey([]byte(material.PrivateKeyPem))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(MarshalHybridPublicKey(sk.PublicKey()), []byte(material.PublicKeyPem)) {
+		return errors.New("public key doesn't match the private key")
+	}
+	return nil
+}
+
+func (hybridKeyAlgorithm) WrapDataKey(material *KeyMaterial, dataKey []byte) ([]byte, error) {
+	sk, err := ParseHybridPrivateKey([]byte(material.PrivateKeyPem))
+	if err != nil {
+		return nil, err
+	}
+	return HybridWrapDataKey(sk.PublicKey(), dataKey)
+}
+
+func (hybridKeyAlgorithm) UnwrapDataKey(material *KeyMaterial, wrappedKey []byte) ([]byte, error) {
+	sk, err := ParseHybridPrivateKey([]byte(material.PrivateKeyPem))
+	if err != nil {
+		return nil, err
+	}
+	return HybridUnwrapDataKey(sk, wrappedKey)
+}
+
+func (hybridKeyAlgorithm) PublicKeyPem(material *KeyMaterial) []byte {
+	return []byte(material.PublicKeyPem)
+}
+
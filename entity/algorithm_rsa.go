@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"path"
+)
+
+// RSA keys wrap data keys using RSA-OAEP, storing their keypair as files within
+//  the key store
+type rsaKeyAlgorithm struct {
+	noSigning
+}
+
+func init() {
+	RegisterKeyAlgorithm(rsaKeyAlgorithm{})
+}
+
+func (rsaKeyAlgorithm) Name() string {
+	return "rsa"
+}
+
+func (rsaKeyAlgorithm) Description() string {
+	return "Data keys wrapped using RSA-OAEP"
+}
+
+func (rsaKeyAlgorithm) Generate(material *KeyMaterial) error {
+	if err := ValidateRSAKeySize(material.KeySize); err != nil {
+		return err
+	}
+	if err := ValidateOAEPHash(material.OAEPHash); err != nil {
+		return err
+	}
+
+	aesKey, err := GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	skPath := KeyMaterialPath(material.StoreName)
+	e1, err := GenerateKeys(path.Dir(skPath), path.Base(skPath), material.KeySize, aesKey)
+	if err != nil {
+		return err
+	}
+
+	// Encrypt the AES Key, stored as the base64 encrypted aes key
+	if material.CipherEncKey, err = encryptRSACipherKey(e1.PrivateKey, aesKey, material.OAEPHash); err != nil {
+		return err
+	}
+
+	// Key Store entries are named after the version's key store name
+	e1.Name = material.StoreName
+	Store.Add(e1)
+	return nil
+}
+
+// Creates the key material of a version from an existing RSA private key, such as
+//  one imported from a standard format. The keypair is stored as files within the
+//  key store & a random cipher key is generated, encrypted by the keypair.
+func NewRSAKeyMaterial(material *KeyMaterial, sk *rsa.PrivateKey) error {
+	material.KeySize = sk.N.BitLen()
+	if err := ValidateRSAKeySize(material.KeySize); err != nil {
+		return err
+	}
+	if err := ValidateOAEPHash(material.OAEPHash); err != nil {
+		return err
+	}
+
+	aesKey, err := GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	if material.CipherEncKey, err = encryptRSACipherKey(sk, aesKey, material.OAEPHash); err != nil {
+		return err
+	}
+	if err := writeKeyPairFiles(sk, material.StoreName); err != nil {
+		return err
+	}
+
+	Store.Add(Entity{
+		PrivateKey: sk,
+		PublicKey:  &sk.PublicKey,
+		Name:       material.StoreName,
+	})
+	return nil
+}
+
+// Encrypts the AES cipher key using the keypair, encoded as base64
+func encryptRSACipherKey(sk *rsa.PrivateKey, aesKey []byte, oaepHash string) (string, error) {
+	encryptedAesKey := bytes.NewBufferString("")
+	if err := Encrypt(aesKey, encryptedAesKey, sk, oaepHash); err != nil {
+		return "", errors.New("failed to encrypt aes key")
+	}
+	return base64.StdEncoding.EncodeToString(encryptedAesKey.Bytes()), nil
+}
+
+func (rsaKeyAlgorithm) Load(material *KeyMaterial) error {
+	// Verify no monkey business and the keypair was stored along with the key
+	if !Store.Has(material.StoreName) {
+		return errors.New("no key store entry to match the rsa key")
+	}
+	e := Store.Get(material.StoreName)
+	if material.KeySize == 0 {
+		material.KeySize = e.PrivateKey.N.BitLen()
+	}
+	return nil
+}
+
+func (rsaKeyAlgorithm) WrapDataKey(material *KeyMaterial, dataKey []byte) ([]byte, error) {
+	return RSAWrapDataKey(Store.Get(material.StoreName), dataKey, material.OAEPHash)
+}
+
+func (rsaKeyAlgorithm) UnwrapDataKey(material *KeyMaterial, wrappedKey []byte) ([]byte, error) {
+	return RSAUnwrapDataKey(Store.Get(material.StoreName), wrappedKey, material.OAEPHash)
+}
+
+func (rsaKeyAlgorithm) CipherKey(material *KeyMaterial) ([]byte, error) {
+	return RSACipherKey(Store.Get(material.StoreName), material.CipherEncKey, material.OAEPHash)
+}
+
+func (rsaKeyAlgorithm) PublicKeyPem(material *KeyMaterial) []byte {
+	if !Store.Has(material.StoreName) {
+		return nil
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(Store.Get(material.StoreName).PublicKey),
+	})
+}
+
+func (rsaKeyAlgorithm) ExportKeyPair(material *KeyMaterial) (*KeyPair, error) {
+	if !Store.Has(material.StoreName) {
+		return nil, errors.New("no key store entry to match the rsa key")
+	}
+	e := Store.Get(material.StoreName)
+	return &KeyPair{
+		PrivateKeyPem: MarshalPrivateKey(e.PrivateKey),
+		PublicKeyPem: pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PUBLIC KEY",
+			Bytes: x509.MarshalPKCS1PublicKey(e.PublicKey),
+		}),
+	}, nil
+}
+
+func (rsaKeyAlgorithm) ValidateKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	if keyPair == nil {
+		return errors.New("missing rsa keypair")
+	}
+	sk, err := ParsePrivateKey(keyPair.PrivateKeyPem)
+	if err != nil {
+		return err
+	}
+	if !ValidateKeyPair(sk) {
+		return errors.New("invalid rsa private key")
+	}
+	if material.KeySize != 0 && sk.N.BitLen() != material.KeySize {
+		return errors.New("rsa key size mismatch")
+	}
+	decodedKey, _ := pem.Decode(keyPair.PublicKeyPem)
+	if decodedKey == nil {
+		return errors.New("no pem encoded public key found")
+	}
+	pk, err := x509.ParsePKCS1PublicKey(decodedKey.Bytes)
+	if err != nil {
+		return err
+	}
+	if !sk.PublicKey.Equal(pk) {
+		return errors.New("rsa public key doesn't match the private key")
+	}
+
+	// Stored cipher keys are encrypted by the keypair
+	if material.CipherEncKey != "" {
+		if _, err := RSACipherKey(&Entity{PrivateKey: sk, PublicKey: &sk.PublicKey}, material.CipherEncKey, material.OAEPHash); err != nil {
+			return errors.New("cipher key not encrypted by the rsa keypair")
+		}
+	}
+	return nil
+}
+
+func (rsaKeyAlgorithm) ImportKeyPair(material *KeyMaterial, keyPair *KeyPair) error {
+	sk, err := ParsePrivateKey(keyPair.PrivateKeyPem)
+	if err != nil {
+		return err
+	}
+	if err := writeKeyPairFiles(sk, material.StoreName); err != nil {
+		return err
+	}
+	Store.Add(Entity{
+		PrivateKey: sk,
+		PublicKey:  &sk.PublicKey,
+		Name:       material.StoreName,
+	})
+	return nil
+}
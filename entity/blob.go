@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Encrypted blob binary format stored in internal storage:
+//  magic | version | cipher id | flags | key id length | key id | key version |
+//  nonce length | nonce | wrapped key length | wrapped key | ciphertext
+//
+//  Version 1 blobs have no wrapped key, their data being encrypted directly using
+//  the key's cipher.
+var blobMagic = []byte{0x89, 'O', 'A', 'B'}
+
+// Current blob format version
+const BlobFormatVersion = uint8(2)
+
+// Blob header flags
+const (
+	BlobFlag_Compressed = uint8(1 << 0) // Plaintext was compressed prior to encryption
+)
+
+var (
+	// Returned when reading a blob stored prior to the binary format, which is
+	//  base64 encoded and has no header
+	ErrLegacyBlob = errors.New("legacy blob format")
+)
+
+// Metadata stored at the start of every encrypted blob
+type BlobHeader struct {
+	Version         uint8
+	CipherAlgorithm string
+	Compressed      bool
+	KeyId           string
+	KeyVersion      uint32
+	Nonce           []byte
+	WrappedKey      []byte // Per-file data key, wrapped using the key
+}
+
+// nopWriteCloser prevents closing the underlying writer once the cipher writer
+//  is closed
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// Encodes the header into its binary format
+func (header *BlobHeader) MarshalBinary() ([]byte, error) {
+	algorithm, err := GetCipherAlgorithm(header.CipherAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	if len(header.KeyId) > 0xff || len(header.Nonce) > 0xff || len(header.WrappedKey) > 0xffff {
+		return nil, errors.New("blob header field too long")
+	}
+
+	flags := uint8(0)
+	if header.Compressed {
+		flags |= BlobFlag_Compressed
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	buffer.Write(blobMagic)
+	buffer.Write([]byte{header.Version, algorithm.BlobId(), flags, uint8(len(header.KeyId))})
+	buffer.WriteString(header.KeyId)
+	binary.Write(buffer, binary.BigEndian, header.KeyVersion)
+	buffer.WriteByte(uint8(len(header.Nonce)))
+	buffer.Write(header.Nonce)
+	if header.Version >= 2 {
+		binary.Write(buffer, binary.BigEndian, uint16(len(header.WrappedKey)))
+		buffer.Write(header.WrappedKey)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Header bytes authenticated along with the encrypted data. The data key wrapping
+//  fields are excluded, allowing the data key to be re-wrapped without re-encrypting
+//  the data, since a tampered wrapped key fails to decrypt the data regardless.
+//  Version 1 blobs, having no wrapped key, authenticate the whole header.
+func (header *BlobHeader) authenticatedData() ([]byte, error) {
+	if header.Version < 2 {
+		return header.MarshalBinary()
+	}
+
+	authHeader := *header
+	authHeader.KeyVersion = 0
+	authHeader.WrappedKey = nil
+	return authHeader.MarshalBinary()
+}
+
+// Reads the binary header from given reader, leaving the reader at the start of
+//  the ciphertext. Returns ErrLegacyBlob, without consuming the reader, if the
+//  blob has no header.
+func ReadBlobHeader(srcReader *bufio.Reader) (*BlobHeader, error) {
+	if magic, err := srcReader.Peek(len(blobMagic)); err != nil || !bytes.Equal(magic, blobMagic) {
+		return nil, ErrLegacyBlob
+	}
+	srcReader.Discard(len(blobMagic))
+
+	// Fixed fields
+	fields := make([]byte, 4)
+	if _, err := io.ReadFull(srcReader, fields); err != nil {
+		return nil, errors.New("blob header too short")
+	}
+	header := &BlobHeader{
+		Version:    fields[0],
+		Compressed: fields[2]&BlobFlag_Compressed != 0,
+	}
+	if header.Version == 0 || header.Version > BlobFormatVersion {
+		return nil, errors.New("blob format version not supported")
+	}
+	algorithm, err := getCipherAlgorithmByBlobId(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	header.CipherAlgorithm = algorithm.Name()
+
+	// Variable length fields
+	keyId := make([]byte, fields[3])
+	if _, err := io.ReadFull(srcReader, keyId); err != nil {
+		return nil, errors.New("blob header too short")
+	}
+	header.KeyId = string(keyId)
+	if err := binary.Read(srcReader, binary.BigEndian, &header.KeyVersion); err != nil {
+		return nil, errors.New("blob header too short")
+	}
+	nonceLength, err := srcReader.ReadByte()
+	if err != nil {
+		return nil, errors.New("blob header too short")
+	}
+	header.Nonce = make([]byte, nonceLength)
+	if _, err := io.ReadFull(srcReader, header.Nonce); err != nil {
+		return nil, errors.New("blob header too short")
+	}
+	if header.Version >= 2 {
+		var wrappedKeyLength uint16
+		if err := binary.Read(srcReader, binary.BigEndian, &wrappedKeyLength); err != nil {
+			return nil, errors.New("blob header too short")
+		}
+		header.WrappedKey = make([]byte, wrappedKeyLength)
+		if _, err := io.ReadFull(srcReader, header.WrappedKey); err != nil {
+			return nil, errors.New("blob header too short")
+		}
+	}
+
+	return header, nil
+}
+
+// Creates a writer that writes the blob header followed by the data written to it
+//  encrypted using the key & the header's cipher algorithm, authenticating the header
+//  if the cipher supports it. The header's version and nonce are generated. The
+//  returned writer must be closed in order to flush the data.
+func NewBlobWriter(destWriter io.Writer, key []byte, header *BlobHeader) (io.WriteCloser, error) {
+	algorithm, err := GetCipherAlgorithm(header.CipherAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Version = BlobFormatVersion
+	header.Nonce = make([]byte, algorithm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, header.Nonce); err != nil {
+		return nil, err
+	}
+	headerBuffer, err := header.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := destWriter.Write(headerBuffer); err != nil {
+		return nil, err
+	}
+
+	// Authenticate the header along with every chunk
+	additionalData, err := header.authenticatedData()
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.NewEncryptWriter(nopWriteCloser{destWriter}, key, header.Nonce, additionalData)
+}
+
+// Creates a reader that decrypts the ciphertext following the blob header, read
+//  using ReadBlobHeader, using the key
+func NewBlobReader(srcReader io.Reader, key []byte, header *BlobHeader) (io.Reader, error) {
+	algorithm, err := GetCipherAlgorithm(header.CipherAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	additionalData, err := header.authenticatedData()
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.NewDecryptReader(srcReader, key, header.Nonce, additionalData)
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"errors"
+	"io"
+	"sort"
+)
+
+// CipherAlgorithm implements the symmetric encryption of blob data, registered by
+//  name using RegisterCipherAlgorithm
+type CipherAlgorithm interface {
+	// Unique name of the cipher, stored along with keys & files
+	Name() string
+
+	// Short human readable description of the cipher
+	Description() string
+
+	// Unique id of the cipher, stored within blob headers
+	BlobId() uint8
+
+	// Whether the cipher authenticates the encrypted data. Only authenticated
+	//  ciphers are selectable by keys.
+	Authenticated() bool
+
+	// Size in bytes of the random nonce stored within blob headers
+	NonceSize() int
+
+	// Creates a writer encrypting data written to it into destWriter using the key &
+	//  nonce, authenticating the additional data along with it. The returned writer
+	//  must be closed in order to flush the data.
+	NewEncryptWriter(destWriter io.WriteCloser, key []byte, nonce []byte, additionalData []byte) (io.WriteCloser, error)
+
+	// Creates a reader decrypting data read from srcReader, encrypted using
+	//  NewEncryptWriter. Authenticated ciphers return ErrIntegrity on tampered data.
+	NewDecryptReader(srcReader io.Reader, key []byte, nonce []byte, additionalData []byte) (io.Reader, error)
+}
+
+// Registered cipher algorithms by name
+var cipherAlgorithms = map[string]CipherAlgorithm{}
+
+// Registers the cipher algorithm, panics if the name or blob id is already registered
+func RegisterCipherAlgorithm(algorithm CipherAlgorithm) {
+	for _, registered := range cipherAlgorithms {
+		if registered.Name() == algorithm.Name() || registered.BlobId() == algorithm.BlobId() {
+			panic("cipher algorithm '" + algorithm.Name() + "' already registered")
+		}
+	}
+	cipherAlgorithms[algorithm.Name()] = algorithm
+}
+
+// Returns the registered cipher algorithm by name
+func GetCipherAlgorithm(name string) (CipherAlgorithm, error) {
+	if algorithm, ok := cipherAlgorithms[name]; ok {
+		return algorithm, nil
+	}
+	return nil, errors.New("cipher algorithm '" + name + "' not supported")
+}
+
+// Returns the registered cipher algorithm a key is able to select by name, being
+//  an authenticated cipher
+func GetSelectableCipherAlgorithm(name string) (CipherAlgorithm, error) {
+	algorithm, err := GetCipherAlgorithm(name)
+	if err != nil {
+		return nil, err
+	}
+	if !algorithm.Authenticated() {
+		return nil, errors.New("cipher algorithm '" + name + "' is unauthenticated, only supported for existing files")
+	}
+	return algorithm, nil
+}
+
+// Returns the registered cipher algorithm by its blob id
+func getCipherAlgorithmByBlobId(blobId uint8) (CipherAlgorithm, error) {
+	for _, algorithm := range cipherAlgorithms {
+		if algorithm.BlobId() == blobId {
+			return algorithm, nil
+		}
+	}
+	return nil, errors.New("blob cipher not supported")
+}
+
+// Returns all registered cipher algorithms, ordered by name
+func CipherAlgorithms() []CipherAlgorithm {
+	algorithms := make([]CipherAlgorithm, 0, len(cipherAlgorithms))
+	for _, algorithm := range cipherAlgorithms {
+		algorithms = append(algorithms, algorithm)
+	}
+	sort.Slice(algorithms, func(i, j int) bool {
+		return algorithms[i].Name() < algorithms[j].Name()
+	})
+	return algorithms
+}
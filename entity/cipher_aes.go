@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"io"
+)
+
+// cfbCipherAlgorithm encrypts blob data using the legacy unauthenticated AES-CFB,
+//  only kept to decrypt & migrate existing files
+type cfbCipherAlgorithm struct{}
+
+func init() {
+	RegisterCipherAlgorithm(cfbCipherAlgorithm{})
+	RegisterCipherAlgorithm(aeadCipherAlgorithm{
+		name:        CipherAES_GCM,
+		description: "AES-256-GCM, authenticated in chunks",
+		blobId:      2,
+		nonceSize:   12,
+		newAEAD: func(key []byte) (cipher.AEAD, error) {
+			c, err := aes.NewCipher(key)
+			if err != nil {
+				return nil, err
+			}
+			return cipher.NewGCM(c)
+		},
+	})
+}
+
+func (cfbCipherAlgorithm) Name() string {
+	return CipherAES_CFB
+}
+
+func (cfbCipherAlgorithm) Description() string {
+	return "Legacy unauthenticated AES-CFB"
+}
+
+func (cfbCipherAlgorithm) BlobId() uint8 {
+	return 1
+}
+
+func (cfbCipherAlgorithm) Authenticated() bool {
+	return false
+}
+
+func (cfbCipherAlgorithm) NonceSize() int {
+	return aes.BlockSize
+}
+
+func (cfbCipherAlgorithm) NewEncryptWriter(destWriter io.WriteCloser, key []byte, nonce []byte, additionalData []byte) (io.WriteCloser, error) {
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != c.BlockSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+	return cipher.StreamWriter{
+		S: cipher.NewCFBEncrypter(c, nonce),
+		W: destWriter,
+	}, nil
+}
+
+func (cfbCipherAlgorithm) NewDecryptReader(srcReader io.Reader, key []byte, nonce []byte, additionalData []byte) (io.Reader, error) {
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != c.BlockSize() {
+		return nil, errors.New("invalid blob nonce")
+	}
+	return cipher.StreamReader{
+		S: cipher.NewCFBDecrypter(c, nonce),
+		R: srcReader,
+	}, nil
+}
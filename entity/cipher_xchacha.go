@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// XChaCha20-Poly1305 doesn't rely on AES hardware support, and its extended nonce
+//  leaves plenty of room for random nonce prefixes
+func init() {
+	RegisterCipherAlgorithm(aeadCipherAlgorithm{
+		name:        CipherXChaCha20_Poly1305,
+		description: "XChaCha20-Poly1305, authenticated in chunks",
+		blobId:      3,
+		nonceSize:   chacha20poly1305.NonceSizeX,
+		newAEAD:     chacha20poly1305.NewX,
+	})
+}
@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"crypto/aes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+)
+
+// Supported ECDH curves, named after the key algorithm using them
+const (
+	ECDH_X25519 = "x25519"
+	ECDH_P256   = "p256"
+)
+
+// HKDF info binding derived key wrapping keys to their purpose
+var ecdhWrapInfo = "openabyss ecdh data key wrap"
+
+// Returns the ECDH curve by name
+func ecdhCurve(name string) (ecdh.Curve, error) {
+	switch name {
+	case ECDH_X25519:
+		return ecdh.X25519(), nil
+	case ECDH_P256:
+		return ecdh.P256(), nil
+	}
+	return nil, errors.New("ecdh curve '" + name + "' not supported")
+}
+
+// Generates a new ECDH private key on the given curve
+func GenerateECDHKey(curveName string) (*ecdh.PrivateKey, error) {
+	curve, err := ecdhCurve(curveName)
+	if err != nil {
+		return nil, err
+	}
+	return curve.GenerateKey(rand.Reader)
+}
+
+// Encodes the ECDH private key as a PKCS#8 PEM
+func MarshalECDHPrivateKey(sk *ecdh.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// Encodes the ECDH public key as a PKIX PEM
+func MarshalECDHPublicKey(pk *ecdh.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// Parses the PKCS#8 PEM encoded ECDH private key of the given curve
+func ParseECDHPrivateKey(curveName string, rawKey []byte) (*ecdh.PrivateKey, error) {
+	curve, err := ecdhCurve(curveName)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(decodedKey.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// NIST curve keys are parsed as ECDSA keys
+	var sk *ecdh.PrivateKey
+	switch k := key.(type) {
+	case *ecdh.PrivateKey:
+		sk = k
+	case *ecdsa.PrivateKey:
+		if sk, err = k.ECDH(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("key is not an ecdh key")
+	}
+
+	// Keys of other curves parse just as well, make sure it's the expected one
+	if sk.Curve() != curve {
+		return nil, errors.New("key is not a '" + curveName + "' key")
+	}
+	return sk, nil
+}
+
+// Parses the PKIX PEM encoded ECDH public key of the given curve
+func ParseECDHPublicKey(curveName string, rawKey []byte) (*ecdh.PublicKey, error) {
+	curve, err := ecdhCurve(curveName)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(decodedKey.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// NIST curve keys are parsed as ECDSA keys
+	var pk *ecdh.PublicKey
+	switch k := key.(type) {
+	case *ecdh.PublicKey:
+		pk = k
+	case *ecdsa.PublicKey:
+		if pk, err = k.ECDH(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("key is not an ecdh key")
+	}
+
+	if pk.Curve() != curve {
+		return nil, errors.New("key is not a '" + curveName + "' key")
+	}
+	return pk, nil
+}
+
+// Derives the key wrapping key from the ECDH shared secret, bound to both the
+//  ephemeral & recipient public keys
+func ecdhWrappingKey(sharedSecret []byte, ephemeralPk []byte, recipientPk []byte) ([]byte, error) {
+	salt := append(append([]byte{}, ephemeralPk...), recipientPk...)
+	return hkdf.Key(sha256.New, sharedSecret, salt, ecdhWrapInfo, DataKeySize)
+}
+
+// Wraps given data key to the ECDH public key (ECDH + HKDF-SHA256 + AES-GCM). A new
+//  ephemeral key is generated per wrap, prepending its public key to the wrapped key.
+func ECDHWrapDataKey(pk *ecdh.PublicKey, dataKey []byte) ([]byte, error) {
+	ephemeralSk, err := pk.Curve().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := ephemeralSk.ECDH(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralPk := ephemeralSk.PublicKey().Bytes()
+	wrappingKey, err := ecdhWrappingKey(sharedSecret, ephemeralPk, pk.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := CipherSeal(c, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(ephemeralPk, wrappedKey...), nil
+}
+
+// Unwraps given data key, wrapped using ECDHWrapDataKey, with the ECDH private key.
+//  Returns ErrIntegrity if the wrapped key was tampered with or wrapped to another key.
+func ECDHUnwrapDataKey(sk *ecdh.PrivateKey, wrappedKey []byte) ([]byte, error) {
+	// Ephemeral public key size is fixed by the curve
+	ephemeralPkSize := len(sk.PublicKey().Bytes())
+	if len(wrappedKey) < ephemeralPkSize {
+		return nil, errors.New("wrapped data key too short")
+	}
+
+	ephemeralPk, err := sk.Curve().NewPublicKey(wrappedKey[:ephemeralPkSize])
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+	sharedSecret, err := sk.ECDH(ephemeralPk)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+
+	wrappingKey, err := ecdhWrappingKey(sharedSecret, ephemeralPk.Bytes(), sk.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	return CipherOpen(c, wrappedKey[ephemeralPkSize:])
+}
@@ -6,7 +6,6 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/rsa"
-	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"io"
@@ -15,9 +14,14 @@ import (
 )
 
 // Attempts to encrypt given data writer to given destination returning the state of
-//  the encryption
-func Encrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey) error {
-	eBuffer, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, &sk.PublicKey, data, []byte("OAEP Encrypted"))
+//  the encryption. The RSA-OAEP hash defaults to DefaultOAEPHash if empty.
+func Encrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey, oaepHash string) error {
+	h, err := newOAEPHash(oaepHash)
+	if err != nil {
+		return err
+	}
+
+	eBuffer, err := rsa.EncryptOAEP(h, rand.Reader, &sk.PublicKey, data, []byte("OAEP Encrypted"))
 	if err != nil {
 		log.Println("could not encrypt file:", err.Error())
 		return err
@@ -32,15 +36,20 @@ func Encrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey) error {
 }
 
 // Attempts to decrypt given encrypted data to destination returning the state of
-//  the decryption
-func Decrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey) error {
+//  the decryption. The RSA-OAEP hash defaults to DefaultOAEPHash if empty.
+func Decrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey, oaepHash string) error {
+	h, err := newOAEPHash(oaepHash)
+	if err != nil {
+		return err
+	}
+
 	fileBuffer, err := base64.StdEncoding.DecodeString(string(data))
 	if err != nil {
 		log.Println("could not base64 decode file:", err.Error())
 		return err
 	}
 
-	eBuffer, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, sk, fileBuffer, []byte("OAEP Encrypted"))
+	eBuffer, err := rsa.DecryptOAEP(h, rand.Reader, sk, fileBuffer, []byte("OAEP Encrypted"))
 	if err != nil {
 		log.Println("could not decrypt file:", err.Error())
 		return err
@@ -53,110 +62,55 @@ func Decrypt(data []byte, destWriter io.Writer, sk *rsa.PrivateKey) error {
 	return nil
 }
 
-// Helper funciton that decrypts the given Base64 Encoded and Decrypted CipherKey
-//  returning the cipher block from the encrypted key
-func decryptAesCipherBlock(pk *rsa.PrivateKey, encCipherKey []byte) (cipher.Block, error) {
+// Helper funciton that decrypts the given Base64 Encoded and Encrypted CipherKey
+//  returning the AES key
+func decryptAesCipherKey(pk *rsa.PrivateKey, encCipherKey []byte, oaepHash string) ([]byte, error) {
 	// Decrypt Cipher
 	if cipherKey, err := base64.StdEncoding.DecodeString(string(encCipherKey)); err != nil {
 		return nil, err
 	} else {
 		// Use private key to decrypt AES Key
 		cipherKeyBuffer := bytes.NewBufferString("")
-		if err := Decrypt(cipherKey, cipherKeyBuffer, pk); err != nil {
-			return nil, err
-		}
-
-		// Create Cipher Block from obtained key
-		if cipher, err := aes.NewCipher(cipherKeyBuffer.Bytes()); err != nil {
+		if err := Decrypt(cipherKey, cipherKeyBuffer, pk, oaepHash); err != nil {
 			return nil, err
-		} else {
-			return cipher, nil
 		}
+		return cipherKeyBuffer.Bytes(), nil
 	}
 }
 
-// Encrypts given data into writer using given cipher block
-func CipherEncrypt(data []byte, destWriter io.Writer, c cipher.Block) error {
-	// Encrypt the data
-	// Create iv & prepend to ciphertext
-	cipherText := make([]byte, c.BlockSize()+len(data))
-	iv := cipherText[:c.BlockSize()]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return err
-	}
-
-	// Start Encrypting
-	stream := cipher.NewCFBEncrypter(c, iv)
-	stream.XORKeyStream(cipherText[c.BlockSize():], data)
-
-	if len(cipherText) == 0 {
-		return errors.New("internal error: failed to encrypt data using cipher")
-	}
-
-	// Write Encrypted data -> Base64 -> IO Writer
-	_, err := destWriter.Write([]byte(base64.StdEncoding.EncodeToString(cipherText)))
-	if !utils.HandleErr(err, "could not write encrypted data to writer") {
-		return err
-	}
-	return nil
-}
-
-// Decrypts given data into writer using given cipher block
-func CipherDecrypt(data []byte, destWriter io.Writer, c cipher.Block) error {
-	// Convert base64 -> ciphertext
-	cipherText, err := base64.StdEncoding.DecodeString(string(data))
-	if err != nil {
-		log.Println("could not base64 decode file:", err.Error())
-		return err
-	}
-
-	// Decrypt the ciphertext
-	if len(cipherText) < c.BlockSize() {
-		return errors.New("ciphertext too short")
-	}
-	plainText := make([]byte, len(cipherText)-c.BlockSize())
+// Creates a reader that decrypts the data read from srcReader using given
+//  cipher block
+func CipherDecryptReader(srcReader io.Reader, c cipher.Block) (io.Reader, error) {
+	// Base64 -> ciphertext
+	decoder := base64.NewDecoder(base64.StdEncoding, srcReader)
 
 	// Extract iv from prepended ciphertext
-	iv := cipherText[:c.BlockSize()]
-
-	// Start Decrypting
-	stream := cipher.NewCFBDecrypter(c, iv)
-	stream.XORKeyStream(plainText, cipherText[c.BlockSize():])
-
-	if len(plainText) == 0 {
-		return errors.New("internal error: failed to decrypt data using cipher")
+	iv := make([]byte, c.BlockSize())
+	if _, err := io.ReadFull(decoder, iv); err != nil {
+		log.Println("could not read cipher iv:", err.Error())
+		return nil, errors.New("ciphertext too short")
 	}
 
-	// Write Decrypter Data -> IO Writer
-	_, err = destWriter.Write(plainText)
-	if !utils.HandleErr(err, "could not write decrypted data to writer") {
-		return err
-	}
-	return nil
+	return cipher.StreamReader{
+		S: cipher.NewCFBDecrypter(c, iv),
+		R: decoder,
+	}, nil
 }
 
-// Attempts to encrypt given data writer using cipher to given destination returning the state of
-//  the encryption.
-func RSACipherEncrypt(data []byte, destWriter io.Writer, entity *Entity, aesEncryptedKey string) error {
-	// Decrypt Cipher
-	c, err := decryptAesCipherBlock(entity.PrivateKey, []byte(aesEncryptedKey))
-	if err != nil {
-		return err
+// Creates a reader that decrypts legacy base64 encoded srcReader using the AES key.
+//  Only AES-CFB was stored in the legacy format.
+func NewCipherReader(srcReader io.Reader, key []byte, cipherAlgorithm string) (io.Reader, error) {
+	if cipherAlgorithm != CipherAES_CFB {
+		return nil, errors.New("cipher algorithm '" + cipherAlgorithm + "' not supported")
 	}
-
-	// Encrpyt data using decrypted Cipher into the Writer
-	return CipherEncrypt(data, destWriter, c)
-}
-
-// Attempts to decrypt given encrypted data using cipher to destination returning the state of
-//  the decryption.
-func RSACipherDecrypt(data []byte, destWriter io.Writer, entity *Entity, aesEncryptedKey string) error {
-	// Decrypt Cipher
-	c, err := decryptAesCipherBlock(entity.PrivateKey, []byte(aesEncryptedKey))
+	c, err := aes.NewCipher(key)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return CipherDecryptReader(srcReader, c)
+}
 
-	// Decrypt data using decrypted Cipher into the Writer
-	return CipherDecrypt(data, destWriter, c)
+// Obtains the encrypted cipher key, decrypted using the entity's private key
+func RSACipherKey(entity *Entity, aesEncryptedKey string, oaepHash string) ([]byte, error) {
+	return decryptAesCipherKey(entity.PrivateKey, []byte(aesEncryptedKey), oaepHash)
 }
@@ -8,8 +8,7 @@ import (
 
 var (
 	Store EntityStore = EntityStore{
-		Keys:   make(map[string]Entity),
-		Length: 0,
+		Keys: make(map[string]Entity),
 	}
 	KeyStorePath = path.Join(storage.InternalStoragePath, "keys")
 )
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// Size in bytes of the per-file data encryption key (AES-256)
+const DataKeySize = 32
+
+// Generates a random data encryption key, used to encrypt a single file
+func GenerateDataKey() ([]byte, error) {
+	dataKey := make([]byte, DataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	return dataKey, nil
+}
+
+// Wraps given data key using the entity's RSA key (RSA-OAEP) & given OAEP hash
+func RSAWrapDataKey(entity *Entity, dataKey []byte, oaepHash string) ([]byte, error) {
+	wrappedKey := bytes.NewBuffer(nil)
+	if err := Encrypt(dataKey, wrappedKey, entity.PrivateKey, oaepHash); err != nil {
+		return nil, err
+	}
+	return wrappedKey.Bytes(), nil
+}
+
+// Unwraps given data key, wrapped using RSAWrapDataKey, with the entity's
+//  private key
+func RSAUnwrapDataKey(entity *Entity, wrappedKey []byte, oaepHash string) ([]byte, error) {
+	dataKey := bytes.NewBuffer(nil)
+	if err := Decrypt(wrappedKey, dataKey, entity.PrivateKey, oaepHash); err != nil {
+		return nil, err
+	}
+	return dataKey.Bytes(), nil
+}
+
+// Seals given data using the cipher block (AES-GCM), prepending the random nonce to
+//  the sealed data. Used to wrap data keys as well as to seal key material at rest.
+func CipherSeal(c cipher.Block, data []byte) ([]byte, error) {
+	aead, err := cipher.NewGCM(c)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// Opens given data, sealed using CipherSeal, with the cipher block. Returns
+//  ErrIntegrity if the sealed data was tampered with or sealed using another key.
+func CipherOpen(c cipher.Block, sealed []byte) ([]byte, error) {
+	aead, err := cipher.NewGCM(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed data too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	data, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+	return data, nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"io/ioutil"
 	"openabyss/utils"
 	"os"
@@ -21,13 +22,16 @@ func ValidateKeyPair(sk *rsa.PrivateKey) bool {
 	return true
 }
 
+// PEM block type of private key files sealed using the master key
+const sealedPrivateKeyPemType = "SEALED RSA PRIVATE KEY"
+
 func GenerateKeys(dir string, keyname string, bits int, aesKey []byte) (Entity, error) {
 	// Generate & Create RSA Keys
 	rsaKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
 	utils.HandleErr(err, "error generating RSA Keypair")
 
 	// Export keys to file
-	err = utils.ExportKeys(rsaKeyPair, dir, keyname)
+	err = ExportKeyPair(rsaKeyPair, dir, keyname)
 	utils.HandleErr(err, "could no export keys to file")
 
 	return Entity{
@@ -37,36 +41,81 @@ func GenerateKeys(dir string, keyname string, bits int, aesKey []byte) (Entity,
 	}, err
 }
 
-func DecodePublicKey(dir string, keyname string) *rsa.PublicKey {
-	// Open the file
-	keyFile, err := os.Open(path.Join(dir, keyname))
-	utils.HandleErr(err, "could not read key file")
-	defer keyFile.Close()
+// Writes the keypair's private & public key files, sealing the private key using
+//  the master key if available. Private key files are only readable by the server.
+func ExportKeyPair(sk *rsa.PrivateKey, dir string, keyname string) error {
+	// Attempt to create the directory (in case not avail)
+	os.MkdirAll(dir, 0700)
+
+	skBuffer, err := marshalStoredPrivateKey(sk)
+	if err != nil {
+		return err
+	}
+	skPath := path.Join(dir, keyname)
+	if err := ioutil.WriteFile(skPath, skBuffer, 0600); err != nil {
+		return err
+	}
+	if err := os.Chmod(skPath, 0600); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(dir, keyname+".pub"), pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&sk.PublicKey),
+	}), 0644)
+}
 
-	// Decode the file
-	rawFileBytes, err := ioutil.ReadAll(keyFile)
-	utils.HandleErr(err, "could not read public key file")
+// Encodes the private key as a PKCS#1 PEM
+func MarshalPrivateKey(sk *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(sk),
+	})
+}
 
-	decodedKey, _ := pem.Decode(rawFileBytes)
-	pk, err := x509.ParsePKCS1PublicKey(decodedKey.Bytes)
-	utils.HandleErr(err, "couldn't parse public key")
+// Encodes the private key as stored within key files, being the PKCS#1 PEM sealed
+//  within a PEM block if the master key is available
+func marshalStoredPrivateKey(sk *rsa.PrivateKey) ([]byte, error) {
+	skPem := MarshalPrivateKey(sk)
+	if !HasMasterKey() {
+		return skPem, nil
+	}
 
-	return pk
+	sealed, err := Seal(skPem)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: sealedPrivateKeyPemType, Bytes: sealed}), nil
 }
 
-func DecodePrivateKey(dir string, keyname string) *rsa.PrivateKey {
-	// Open the file
-	keyFile, err := os.Open(path.Join(dir, keyname))
-	utils.HandleErr(err, "could not read key file")
-	defer keyFile.Close()
+// Whether the private key file content was sealed using the master key
+func isSealedPrivateKey(rawKey []byte) bool {
+	decodedKey, _ := pem.Decode(rawKey)
+	return decodedKey != nil && decodedKey.Type == sealedPrivateKeyPemType
+}
 
-	// Decode the file
-	rawFileBytes, err := ioutil.ReadAll(keyFile)
-	utils.HandleErr(err, "could not read private key file")
+// Parses the private key as stored within key files, unsealing it using the master
+//  key if sealed
+func ParseStoredPrivateKey(rawKey []byte) (*rsa.PrivateKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	if decodedKey.Type != sealedPrivateKeyPemType {
+		return x509.ParsePKCS1PrivateKey(decodedKey.Bytes)
+	}
 
-	decodedKey, _ := pem.Decode(rawFileBytes)
-	sk, err := x509.ParsePKCS1PrivateKey(decodedKey.Bytes)
-	utils.HandleErr(err, "couldn't parse private key")
+	skPem, err := Unseal(decodedKey.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivateKey(skPem)
+}
 
-	return sk
+// Parses the PEM encoded PKCS#1 private key
+func ParsePrivateKey(rawKey []byte) (*rsa.PrivateKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	return x509.ParsePKCS1PrivateKey(decodedKey.Bytes)
 }
@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"crypto/aes"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/mlkem"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/pem"
+	"errors"
+)
+
+// Post-quantum hybrid key algorithm, combining ML-KEM-768 & X25519
+const KEM_MLKEM768_X25519 = "mlkem768-x25519"
+
+// PEM block types of the hybrid keypair
+const (
+	hybridPrivateKeyPemType = "MLKEM768-X25519 PRIVATE KEY"
+	hybridPublicKeyPemType  = "MLKEM768-X25519 PUBLIC KEY"
+)
+
+// HKDF info binding derived key wrapping keys to their purpose
+var hybridWrapInfo = "openabyss mlkem768-x25519 data key wrap"
+
+// HybridPrivateKey holds both the ML-KEM-768 decapsulation key & the X25519
+//  private key of a hybrid keypair
+type HybridPrivateKey struct {
+	MLKEM  *mlkem.DecapsulationKey768
+	X25519 *ecdh.PrivateKey
+}
+
+// HybridPublicKey holds both the ML-KEM-768 encapsulation key & the X25519
+//  public key of a hybrid keypair
+type HybridPublicKey struct {
+	MLKEM  *mlkem.EncapsulationKey768
+	X25519 *ecdh.PublicKey
+}
+
+// Returns the public key of the hybrid keypair
+func (sk *HybridPrivateKey) PublicKey() *HybridPublicKey {
+	return &HybridPublicKey{
+		MLKEM:  sk.MLKEM.EncapsulationKey(),
+		X25519: sk.X25519.PublicKey(),
+	}
+}
+
+// Encodes the public key as: ML-KEM encapsulation key | X25519 public key
+func (pk *HybridPublicKey) Bytes() []byte {
+	return append(pk.MLKEM.Bytes(), pk.X25519.Bytes()...)
+}
+
+// Generates a new hybrid ML-KEM-768 & X25519 keypair
+func GenerateHybridKey() (*HybridPrivateKey, error) {
+	mlkemKey, err := mlkem.GenerateKey768()
+	if err != nil {
+		return nil, err
+	}
+	x25519Key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &HybridPrivateKey{MLKEM: mlkemKey, X25519: x25519Key}, nil
+}
+
+// Encodes the hybrid private key as a PEM of: ML-KEM seed | X25519 private key
+func MarshalHybridPrivateKey(sk *HybridPrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  hybridPrivateKeyPemType,
+		Bytes: append(sk.MLKEM.Bytes(), sk.X25519.Bytes()...),
+	})
+}
+
+// Encodes the hybrid public key as a PEM
+func MarshalHybridPublicKey(pk *HybridPublicKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  hybridPublicKeyPemType,
+		Bytes: pk.Bytes(),
+	})
+}
+
+// Parses the PEM encoded hybrid private key, encoded using MarshalHybridPrivateKey
+func ParseHybridPrivateKey(rawKey []byte) (*HybridPrivateKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil || decodedKey.Type != hybridPrivateKeyPemType {
+		return nil, errors.New("no pem encoded hybrid key found")
+	}
+	if len(decodedKey.Bytes) != mlkem.SeedSize+32 {
+		return nil, errors.New("invalid hybrid key size")
+	}
+
+	mlkemKey, err := mlkem.NewDecapsulationKey768(decodedKey.Bytes[:mlkem.SeedSize])
+	if err != nil {
+		return nil, err
+	}
+	x25519Key, err := ecdh.X25519().NewPrivateKey(decodedKey.Bytes[mlkem.SeedSize:])
+	if err != nil {
+		return nil, err
+	}
+	return &HybridPrivateKey{MLKEM: mlkemKey, X25519: x25519Key}, nil
+}
+
+// Parses the PEM encoded hybrid public key, encoded using MarshalHybridPublicKey
+func ParseHybridPublicKey(rawKey []byte) (*HybridPublicKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil || decodedKey.Type != hybridPublicKeyPemType {
+		return nil, errors.New("no pem encoded hybrid key found")
+	}
+	if len(decodedKey.Bytes) != mlkem.EncapsulationKeySize768+32 {
+		return nil, errors.New("invalid hybrid key size")
+	}
+
+	mlkemKey, err := mlkem.NewEncapsulationKey768(decodedKey.Bytes[:mlkem.EncapsulationKeySize768])
+	if err != nil {
+		return nil, err
+	}
+	x25519Key, err := ecdh.X25519().NewPublicKey(decodedKey.Bytes[mlkem.EncapsulationKeySize768:])
+	if err != nil {
+		return nil, err
+	}
+	return &HybridPublicKey{MLKEM: mlkemKey, X25519: x25519Key}, nil
+}
+
+// Derives the key wrapping key from both shared secrets, bound to the ML-KEM
+//  ciphertext, the ephemeral & the recipient public keys
+func hybridWrappingKey(mlkemSecret []byte, x25519Secret []byte, ciphertext []byte, ephemeralPk []byte, pk *HybridPublicKey) ([]byte, error) {
+	secret := append(append([]byte{}, mlkemSecret...), x25519Secret...)
+	salt := append(append(append([]byte{}, ciphertext...), ephemeralPk...), pk.Bytes()...)
+	return hkdf.Key(sha256.New, secret, salt, hybridWrapInfo, DataKeySize)
+}
+
+// Wraps given data key to the hybrid public key. The key wrapping key is derived
+//  (HKDF-SHA256) from both an ML-KEM-768 encapsulated secret & an ephemeral X25519
+//  shared secret, wrapping the data key using AES-GCM. The wrapped key is laid out as:
+//  ML-KEM ciphertext | ephemeral X25519 public key | wrapped key.
+func HybridWrapDataKey(pk *HybridPublicKey, dataKey []byte) ([]byte, error) {
+	mlkemSecret, ciphertext := pk.MLKEM.Encapsulate()
+
+	ephemeralSk, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	x25519Secret, err := ephemeralSk.ECDH(pk.X25519)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralPk := ephemeralSk.PublicKey().Bytes()
+	wrappingKey, err := hybridWrappingKey(mlkemSecret, x25519Secret, ciphertext, ephemeralPk, pk)
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := CipherSeal(c, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(ciphertext, ephemeralPk...), wrappedKey...), nil
+}
+
+// Unwraps given data key, wrapped using HybridWrapDataKey, with the hybrid private key.
+//  Returns ErrIntegrity if the wrapped key was tampered with or wrapped to another key.
+func HybridUnwrapDataKey(sk *HybridPrivateKey, wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < mlkem.CiphertextSize768+32 {
+		return nil, errors.New("wrapped data key too short")
+	}
+	ciphertext := wrappedKey[:mlkem.CiphertextSize768]
+	ephemeralPkBytes := wrappedKey[mlkem.CiphertextSize768 : mlkem.CiphertextSize768+32]
+
+	// ML-KEM decapsulation implicitly rejects, failing once unwrapped
+	mlkemSecret, err := sk.MLKEM.Decapsulate(ciphertext)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+	ephemeralPk, err := ecdh.X25519().NewPublicKey(ephemeralPkBytes)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+	x25519Secret, err := sk.X25519.ECDH(ephemeralPk)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+
+	wrappingKey, err := hybridWrappingKey(mlkemSecret, x25519Secret, ciphertext, ephemeralPkBytes, sk.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	return CipherOpen(c, wrappedKey[mlkem.CiphertextSize768+32:])
+}
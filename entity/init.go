@@ -1,13 +1,35 @@
 package entity
 
-import "log"
+import (
+	"log"
+	"openabyss/server/storage"
+	"strings"
+)
 
-func Init() {
-	// Load in Keys if available
-	err := LoadKeys()
-	if err == nil {
-		log.Printf("Loaded in %d keys\n", Store.Length)
-	} else {
-		log.Println("No keys loaded")
+// Loads the key store, migrating keys stored prior to the key store layout. Keys
+//  aren't loaded if the migration fails, leaving the internal storage untouched.
+func Init() error {
+	if storage.Internal.KeyStoreVersion == 0 {
+		migrated, err := MigrateLegacyKeyStore()
+		if err != nil {
+			return err
+		}
+		storage.Internal.KeyStoreVersion = KeyManifestVersion
+		if _, err := storage.Internal.WriteToFile(); err != nil {
+			return err
+		}
+		if migrated > 0 {
+			log.Printf("Migrated %d keys into the key store layout\n", migrated)
+		}
 	}
+
+	quarantined, err := LoadKeyStore()
+	if err != nil {
+		return err
+	}
+	log.Printf("Loaded in %d keys\n", storage.Internal.KeyCount())
+	if len(quarantined) > 0 {
+		log.Printf("Quarantined %d key store entries failing the consistency check: %s\n", len(quarantined), strings.Join(quarantined, ", "))
+	}
+	return nil
 }
@@ -0,0 +1,278 @@
+package entity
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"openabyss/server/storage"
+)
+
+// Format version of exported key packages, packages exported prior to it holding
+//  neither a version nor a checksum
+const KeyTarPackageVersion = uint32(1)
+
+var (
+	// Returned when unpacking a package exported by a newer server
+	ErrKeyPackageVersion = errors.New("key package version not supported")
+
+	// Returned when the package's checksum doesn't match its content
+	ErrKeyPackageChecksum = errors.New("key package checksum mismatch, package was tampered with or corrupted")
+)
+
+// KeyTarPackage encapsulates required Key information for importing
+//  and exporting keys
+type KeyTarPackage struct {
+	FormatVersion uint32             `json:"formatVersion"`
+	Checksum      string             `json:"checksum"` // Hex SHA-256 of the package, without its checksum
+	KeyStoreEntry storage.KeyStorage `json:"keyStoreEntry"`
+	RawPrivateKey []byte             `json:"privateKey"` // Packages exported prior to versions
+	RawPublicKey  []byte             `json:"publicKey"`  // Packages exported prior to versions
+	Versions      []KeyTarVersion    `json:"versions"`
+}
+
+// KeyTarVersion encapsulates the keypair files of a key's version, only held by
+//  algorithms storing their keypair as files
+type KeyTarVersion struct {
+	Version       uint32 `json:"version"`
+	RawPrivateKey []byte `json:"privateKey"`
+	RawPublicKey  []byte `json:"publicKey"`
+}
+
+// Constructs the key material of the packaged key's version, as held by the package
+func packageKeyMaterial(keyName string, entry storage.KeyStorage, keyVersion storage.KeyVersion) *KeyMaterial {
+	return &KeyMaterial{
+		StoreName:     VersionKeyName(keyName, keyVersion.Version),
+		CipherEncKey:  keyVersion.CipherEncKey,
+		PrivateKeyPem: keyVersion.PrivateKey_pem,
+		PublicKeyPem:  keyVersion.PublicKey_pem,
+		KeySize:       entry.KeySize,
+		OAEPHash:      entry.OAEPHash,
+	}
+}
+
+// Packages the stored key, along with the keypair files of its versions based on the
+//  key's algorithm. The stored key's versions are expected unsealed, destroyed
+//  versions being packaged without their keypair.
+func NewKeyTarPackage(keyName string, entry storage.KeyStorage) (*KeyTarPackage, error) {
+	algorithm, err := GetKeyAlgorithm(entry.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &KeyTarPackage{
+		KeyStoreEntry: entry,
+		Versions:      []KeyTarVersion{},
+	}
+	for _, keyVersion := range entry.Versions {
+		if keyVersion.State == storage.KeyVersion_Destroyed {
+			continue
+		}
+		keyPair, err := algorithm.ExportKeyPair(packageKeyMaterial(keyName, entry, keyVersion))
+		if err != nil {
+			return nil, fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+		}
+		if keyPair != nil {
+			pkg.Versions = append(pkg.Versions, KeyTarVersion{
+				Version:       keyVersion.Version,
+				RawPrivateKey: keyPair.PrivateKeyPem,
+				RawPublicKey:  keyPair.PublicKeyPem,
+			})
+		}
+	}
+	return pkg, nil
+}
+
+// Computes the hex SHA-256 checksum of the package's JSON, without its checksum
+func (pkg KeyTarPackage) checksum() (string, error) {
+	pkg.Checksum = ""
+	packageBuffer, err := json.Marshal(pkg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(packageBuffer)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Serializes the package as gzip'd JSON, stamped with the format version & checksum
+func (pkg *KeyTarPackage) Marshal() ([]byte, error) {
+	pkg.FormatVersion = KeyTarPackageVersion
+	checksum, err := pkg.checksum()
+	if err != nil {
+		return nil, err
+	}
+	pkg.Checksum = checksum
+
+	packageBuffer, err := json.Marshal(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	compBuffer := bytes.NewBuffer(nil)
+	writer := gzip.NewWriter(compBuffer)
+	if _, err := writer.Write(packageBuffer); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return compBuffer.Bytes(), nil
+}
+
+// Deserializes the gzip'd JSON package, verifying its format version & checksum.
+//  Moves the keypair of packages exported prior to versions into the key's first
+//  version.
+func UnmarshalKeyTarPackage(buffer []byte) (*KeyTarPackage, error) {
+	reader, err := gzip.NewReader(bytes.NewBuffer(buffer))
+	if err != nil {
+		return nil, errors.New("failed unpack archive")
+	}
+	packageBuffer, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.New("failed unpack archive")
+	}
+
+	var pkg KeyTarPackage
+	if err := json.Unmarshal(packageBuffer, &pkg); err != nil {
+		return nil, fmt.Errorf("malformed key package: %v", err)
+	}
+	if pkg.FormatVersion > KeyTarPackageVersion {
+		return nil, ErrKeyPackageVersion
+	}
+
+	// Packages exported prior to the format version hold no checksum
+	if pkg.FormatVersion > 0 || pkg.Checksum != "" {
+		checksum, err := pkg.checksum()
+		if err != nil {
+			return nil, err
+		}
+		if pkg.Checksum != checksum {
+			return nil, ErrKeyPackageChecksum
+		}
+	}
+
+	// Packages exported prior to versions hold a single keypair
+	pkg.KeyStoreEntry.MigrateLegacyVersion()
+	if len(pkg.Versions) == 0 && len(pkg.RawPrivateKey) > 0 {
+		pkg.Versions = []KeyTarVersion{{
+			Version:       1,
+			RawPrivateKey: pkg.RawPrivateKey,
+			RawPublicKey:  pkg.RawPublicKey,
+		}}
+	}
+	return &pkg, nil
+}
+
+// Returns the packaged keypair files of the key's version, nil if not packaged
+func (pkg *KeyTarPackage) VersionKeyPair(version uint32) *KeyPair {
+	for _, keyVersion := range pkg.Versions {
+		if keyVersion.Version == version {
+			return &KeyPair{
+				PrivateKeyPem: keyVersion.RawPrivateKey,
+				PublicKeyPem:  keyVersion.RawPublicKey,
+			}
+		}
+	}
+	return nil
+}
+
+// Validates the key material of every packaged version based on the key's algorithm,
+//  prior to importing the package under the key name. Keypair files must match the
+//  version's key material, key material held within the version must wrap & unwrap
+//  a data key.
+func (pkg *KeyTarPackage) Validate(keyName string) error {
+	algorithm, err := GetKeyAlgorithm(pkg.KeyStoreEntry.Algorithm)
+	if err != nil {
+		return err
+	}
+	if pkg.KeyStoreEntry.LatestVersion() == nil {
+		return errors.New("key package holds no versions")
+	}
+
+	// Every packaged keypair belongs to a live version
+	for _, packagedVersion := range pkg.Versions {
+		keyVersion := pkg.KeyStoreEntry.GetVersion(packagedVersion.Version)
+		if keyVersion == nil || keyVersion.State == storage.KeyVersion_Destroyed {
+			return fmt.Errorf("keypair packaged for unknown version '%d'", packagedVersion.Version)
+		}
+	}
+
+	for _, keyVersion := range pkg.KeyStoreEntry.Versions {
+		if keyVersion.State == storage.KeyVersion_Destroyed {
+			continue
+		}
+		material := packageKeyMaterial(keyName, pkg.KeyStoreEntry, keyVersion)
+		keyPair := pkg.VersionKeyPair(keyVersion.Version)
+		if err := algorithm.ValidateKeyPair(material, keyPair); err != nil {
+			return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+		}
+
+		// Key material held within the version
+		if keyPair == nil {
+			if err := validateKeyMaterial(algorithm, material); err != nil {
+				return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Loads the key material held within the version, test wrapping & unwrapping a
+//  random data key
+func validateKeyMaterial(algorithm KeyAlgorithm, material *KeyMaterial) error {
+	if err := algorithm.Load(material); err != nil {
+		return err
+	}
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	wrappedKey, err := algorithm.WrapDataKey(material, dataKey)
+	if err != nil {
+		return err
+	}
+	unwrappedKey, err := algorithm.UnwrapDataKey(material, wrappedKey)
+	if err != nil || !bytes.Equal(dataKey, unwrappedKey) {
+		return errors.New("key material failed to unwrap a wrapped data key")
+	}
+	return nil
+}
+
+// Imports the validated package's keypair files under the key name, based on the
+//  key's algorithm
+func (pkg *KeyTarPackage) Import(keyName string) error {
+	algorithm, err := GetKeyAlgorithm(pkg.KeyStoreEntry.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	imported := []string{}
+	for _, keyVersion := range pkg.KeyStoreEntry.Versions {
+		keyPair := pkg.VersionKeyPair(keyVersion.Version)
+		if keyVersion.State == storage.KeyVersion_Destroyed || keyPair == nil {
+			continue
+		}
+		material := packageKeyMaterial(keyName, pkg.KeyStoreEntry, keyVersion)
+		if err := algorithm.ImportKeyPair(material, keyPair); err != nil {
+			// Remove the keypair files imported so far
+			for _, storeName := range append(imported, material.StoreName) {
+				RemoveKeyPairFiles(storeName)
+			}
+			return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+		}
+		imported = append(imported, material.StoreName)
+	}
+	return nil
+}
+
+// Removes the keypair files stored under the store name, along with its Key Store entry
+func RemoveKeyPairFiles(storeName string) {
+	Store.Remove(storeName)
+	os.Remove(KeyMaterialPath(storeName) + ".pub")
+	os.Remove(KeyMaterialPath(storeName))
+}
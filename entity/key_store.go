@@ -0,0 +1,476 @@
+package entity
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"openabyss/server/storage"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Key store layout, every key being stored within its own directory:
+//  <KeyStorePath>/<key name>/manifest.json   Key metadata, versions & checksums of its material files
+//  <KeyStorePath>/<key name>/v<version>      Private key file of keys storing their keypair as files
+//  <KeyStorePath>/<key name>/v<version>.pub  Public key file of keys storing their keypair as files
+//  <KeyStorePath>/.quarantine/               Keys & files failing the startup consistency check
+
+// Format version of key manifests
+const KeyManifestVersion = uint32(1)
+
+const (
+	keyManifestName   = "manifest.json"
+	keyQuarantineName = ".quarantine"
+)
+
+// KeyManifest describes a key stored within the key store, holding its metadata,
+//  versions & the material files of its versions
+type KeyManifest struct {
+	FormatVersion uint32             `json:"formatVersion"`
+	Key           storage.KeyStorage `json:"key"`
+	Files         []KeyMaterialFile  `json:"files"`
+}
+
+// KeyMaterialFile describes a material file of a key's version
+type KeyMaterialFile struct {
+	Version uint32 `json:"version"`
+	Name    string `json:"name"`   // File name within the key's directory
+	Sha256  string `json:"sha256"` // Hex SHA-256 of the file's content
+}
+
+// Validates the key name being usable as the key's directory name
+func ValidateKeyName(keyName string) error {
+	if keyName == "" {
+		return errors.New("key name required")
+	}
+	if strings.HasPrefix(keyName, ".") || strings.ContainsAny(keyName, "/\\\x00") {
+		return errors.New("key name '" + keyName + "' must not start with '.' nor contain path separators")
+	}
+	return nil
+}
+
+// Returns the directory of the key within the key store
+func KeyDir(keyName string) string {
+	return path.Join(KeyStorePath, keyName)
+}
+
+// Returns the path of the material file stored under the version's key store name,
+//  being within the key's directory
+func KeyMaterialPath(storeName string) string {
+	idx := strings.LastIndex(storeName, ".v")
+	if idx < 0 {
+		return path.Join(KeyStorePath, storeName)
+	}
+	return path.Join(KeyDir(storeName[:idx]), storeName[idx+1:])
+}
+
+// Returns the name of the version's material file within the key's directory
+func versionFileName(version uint32) string {
+	return fmt.Sprintf("v%d", version)
+}
+
+// Writes the keypair files of the version's key store name within the key's directory
+func writeKeyPairFiles(sk *rsa.PrivateKey, storeName string) error {
+	skPath := KeyMaterialPath(storeName)
+	return ExportKeyPair(sk, path.Dir(skPath), path.Base(skPath))
+}
+
+// Computes the hex SHA-256 checksum of the file's content
+func fileChecksum(filePath string) (string, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Saves the key's manifest within its directory, recording the checksums of its
+//  versions' material files, and sets the key's internal storage entry. The manifest
+//  is written atomically, replacing the previous one only once fully written.
+func SaveKey(entry storage.KeyStorage) error {
+	if err := ValidateKeyName(entry.Name); err != nil {
+		return err
+	}
+	keyDir := KeyDir(entry.Name)
+	if err := os.MkdirAll(keyDir, 0700); err != nil {
+		return err
+	}
+
+	manifest := KeyManifest{
+		FormatVersion: KeyManifestVersion,
+		Key:           entry,
+		Files:         []KeyMaterialFile{},
+	}
+	for _, keyVersion := range entry.Versions {
+		// Material files of destroyed versions are removed once saved
+		if keyVersion.State == storage.KeyVersion_Destroyed {
+			continue
+		}
+		for _, fileName := range []string{versionFileName(keyVersion.Version), versionFileName(keyVersion.Version) + ".pub"} {
+			checksum, err := fileChecksum(path.Join(keyDir, fileName))
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			manifest.Files = append(manifest.Files, KeyMaterialFile{
+				Version: keyVersion.Version,
+				Name:    fileName,
+				Sha256:  checksum,
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	manifestPath := path.Join(keyDir, keyManifestName)
+	if err := ioutil.WriteFile(manifestPath+".tmp", data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(manifestPath+".tmp", manifestPath); err != nil {
+		return err
+	}
+
+	storage.Internal.SetKey(entry.Name, entry)
+	return nil
+}
+
+// Removes the key's directory, along with its internal storage & Key Store entries
+func RemoveKey(keyName string) error {
+	if err := ValidateKeyName(keyName); err != nil {
+		return err
+	}
+	if entry, ok := storage.Internal.GetKey(keyName); ok {
+		for _, keyVersion := range entry.Versions {
+			Store.Remove(VersionKeyName(keyName, keyVersion.Version))
+		}
+	}
+	if err := os.RemoveAll(KeyDir(keyName)); err != nil {
+		return err
+	}
+	storage.Internal.DeleteKey(keyName)
+	return nil
+}
+
+// Renames the key, moving its directory & the Key Store entries of its versions to
+//  the new key name
+func RenameKey(keyName string, newName string) error {
+	entry, ok := storage.Internal.GetKey(keyName)
+	if !ok {
+		return errors.New("key '" + keyName + "' not found")
+	}
+	if err := ValidateKeyName(newName); err != nil {
+		return err
+	}
+	if _, ok := storage.Internal.GetKey(newName); ok {
+		return errors.New("key '" + newName + "' already exists")
+	}
+	if _, err := os.Stat(KeyDir(newName)); err == nil {
+		return errors.New("key directory '" + newName + "' already exists")
+	}
+	if err := os.Rename(KeyDir(keyName), KeyDir(newName)); err != nil {
+		return err
+	}
+
+	for _, keyVersion := range entry.Versions {
+		Store.Rename(VersionKeyName(keyName, keyVersion.Version), VersionKeyName(newName, keyVersion.Version))
+	}
+	storage.Internal.DeleteKey(keyName)
+	entry.Name = newName
+	return SaveKey(entry)
+}
+
+// Backup of a key's directory, restoring the key as it was once backed up
+type KeyBackup struct {
+	keyName string
+	files   map[string][]byte // Files of the key's directory by name, nil if the key didn't exist
+}
+
+// Backs up the files of the key's directory prior to overwriting the key
+func BackupKey(keyName string) (*KeyBackup, error) {
+	if err := ValidateKeyName(keyName); err != nil {
+		return nil, err
+	}
+	backup := &KeyBackup{keyName: keyName}
+	dirEntries, err := os.ReadDir(KeyDir(keyName))
+	if os.IsNotExist(err) {
+		return backup, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	backup.files = map[string][]byte{}
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(path.Join(KeyDir(keyName), dirEntry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		backup.files[dirEntry.Name()] = data
+	}
+	return backup, nil
+}
+
+// Restores the key's directory as backed up, reloading the key. Keys not existing once
+//  backed up are removed.
+func (backup *KeyBackup) Restore() error {
+	Store.RemoveVersions(backup.keyName)
+	if backup.files == nil {
+		storage.Internal.DeleteKey(backup.keyName)
+		return os.RemoveAll(KeyDir(backup.keyName))
+	}
+
+	keyDir := KeyDir(backup.keyName)
+	dirEntries, err := os.ReadDir(keyDir)
+	if err != nil {
+		return err
+	}
+	for _, dirEntry := range dirEntries {
+		if _, ok := backup.files[dirEntry.Name()]; !ok && !dirEntry.IsDir() {
+			os.Remove(path.Join(keyDir, dirEntry.Name()))
+		}
+	}
+	for name, data := range backup.files {
+		if err := ioutil.WriteFile(path.Join(keyDir, name), data, 0600); err != nil {
+			return err
+		}
+	}
+	return loadKey(backup.keyName)
+}
+
+// Loads every key within the key store, replacing the loaded keys. Each key is loaded
+//  atomically, only once its manifest, material files & the key material of every
+//  version were validated. Keys & files failing the consistency check are reported &
+//  moved into the quarantine directory. Returns the names of the quarantined entries.
+func LoadKeyStore() ([]string, error) {
+	Store.Clear()
+	storage.Internal.ClearKeys()
+
+	dirEntries, err := os.ReadDir(KeyStorePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	quarantined := []string{}
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if name == keyQuarantineName {
+			continue
+		}
+
+		var loadErr error
+		if !dirEntry.IsDir() {
+			loadErr = errors.New("file belongs to no key")
+		} else {
+			loadErr = loadKey(name)
+		}
+		if loadErr == nil {
+			continue
+		}
+
+		log.Printf("[LoadKeyStore]: '%s' failed the consistency check: %v\n", name, loadErr)
+		quarantinePath, err := quarantineKey(name)
+		if err != nil {
+			log.Printf("[LoadKeyStore]: Failed to quarantine '%s': %v\n", name, err)
+			continue
+		}
+		log.Printf("[LoadKeyStore]: Quarantined '%s' into '%s'\n", name, quarantinePath)
+		quarantined = append(quarantined, name)
+	}
+	return quarantined, nil
+}
+
+// Loads the key stored within the directory, adding it to the internal storage & Key
+//  Store only once every version was validated. Private key files stored prior to the
+//  master key are re-written sealed.
+func loadKey(keyName string) error {
+	keyDir := KeyDir(keyName)
+	data, err := ioutil.ReadFile(path.Join(keyDir, keyManifestName))
+	if err != nil {
+		return errors.New("missing key manifest")
+	}
+	var manifest KeyManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("malformed key manifest: %v", err)
+	}
+	if manifest.FormatVersion == 0 || manifest.FormatVersion > KeyManifestVersion {
+		return fmt.Errorf("key manifest version '%d' not supported", manifest.FormatVersion)
+	}
+	entry := manifest.Key
+	if entry.Name != keyName {
+		return fmt.Errorf("manifest key name '%s' doesn't match its directory", entry.Name)
+	}
+	algorithm, err := GetKeyAlgorithm(entry.Algorithm)
+	if err != nil {
+		return err
+	}
+	if entry.LatestVersion() == nil {
+		return errors.New("key holds no versions")
+	}
+
+	// Material files must match their recorded checksums
+	files := map[string][]byte{}
+	for _, file := range manifest.Files {
+		if file.Name != path.Base(file.Name) || entry.GetVersion(file.Version) == nil {
+			return fmt.Errorf("unknown material file '%s'", file.Name)
+		}
+		fileData, err := ioutil.ReadFile(path.Join(keyDir, file.Name))
+		if err != nil {
+			return fmt.Errorf("missing material file '%s'", file.Name)
+		}
+		sum := sha256.Sum256(fileData)
+		if hex.EncodeToString(sum[:]) != file.Sha256 {
+			return fmt.Errorf("material file '%s' checksum mismatch", file.Name)
+		}
+		files[file.Name] = fileData
+	}
+
+	entities := []Entity{}
+	unsealedFiles := false
+	for _, keyVersion := range entry.Versions {
+		if keyVersion.State == storage.KeyVersion_Destroyed {
+			continue
+		}
+		material, err := storedKeyMaterial(keyName, entry, keyVersion)
+		if err != nil {
+			return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+		}
+
+		// Keypair files of the version, validated along with its key material
+		var keyPair *KeyPair
+		rawPrivateKey, ok := files[versionFileName(keyVersion.Version)]
+		if ok {
+			sk, err := ParseStoredPrivateKey(rawPrivateKey)
+			if err != nil {
+				return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+			}
+			keyPair = &KeyPair{
+				PrivateKeyPem: MarshalPrivateKey(sk),
+				PublicKeyPem:  files[versionFileName(keyVersion.Version)+".pub"],
+			}
+			entities = append(entities, Entity{
+				PrivateKey: sk,
+				PublicKey:  &sk.PublicKey,
+				Name:       material.StoreName,
+			})
+			unsealedFiles = unsealedFiles || !isSealedPrivateKey(rawPrivateKey)
+		}
+		if err := algorithm.ValidateKeyPair(material, keyPair); err != nil {
+			return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+		}
+		if keyPair == nil {
+			if err := validateKeyMaterial(algorithm, material); err != nil {
+				return fmt.Errorf("version '%d': %v", keyVersion.Version, err)
+			}
+		}
+	}
+
+	for _, e := range entities {
+		Store.Add(e)
+	}
+	storage.Internal.SetKey(keyName, entry)
+
+	// Seal private key files stored prior to the master key
+	if unsealedFiles && HasMasterKey() {
+		for _, e := range entities {
+			if err := writeKeyPairFiles(e.PrivateKey, e.Name); err != nil {
+				log.Printf("[loadKey]: Failed to seal '%s' key files: %v\n", e.Name, err)
+				return nil
+			}
+		}
+		if err := SaveKey(entry); err != nil {
+			log.Printf("[loadKey]: Failed to save '%s' key manifest: %v\n", keyName, err)
+			return nil
+		}
+		log.Printf("[loadKey]: Sealed '%s' key files using the master key\n", keyName)
+	}
+	return nil
+}
+
+// Constructs the unsealed key material of the stored key's version
+func storedKeyMaterial(keyName string, entry storage.KeyStorage, keyVersion storage.KeyVersion) (*KeyMaterial, error) {
+	cipherEncKey, err := UnsealString(keyVersion.CipherEncKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyPem, err := UnsealString(keyVersion.PrivateKey_pem)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyMaterial{
+		StoreName:     VersionKeyName(keyName, keyVersion.Version),
+		CipherEncKey:  cipherEncKey,
+		PrivateKeyPem: privateKeyPem,
+		PublicKeyPem:  keyVersion.PublicKey_pem,
+		KeySize:       entry.KeySize,
+		OAEPHash:      entry.OAEPHash,
+	}, nil
+}
+
+// Moves the key store entry into the quarantine directory, returning its new path
+func quarantineKey(name string) (string, error) {
+	quarantineDir := path.Join(KeyStorePath, keyQuarantineName)
+	if err := os.MkdirAll(quarantineDir, 0700); err != nil {
+		return "", err
+	}
+	quarantinePath := path.Join(quarantineDir, fmt.Sprintf("%s.%d", name, time.Now().UnixMilli()))
+	return quarantinePath, os.Rename(path.Join(KeyStorePath, name), quarantinePath)
+}
+
+// Moves keys stored prior to the key store layout, their metadata held within the
+//  internal storage & their keypair files held flat within the key store, into their
+//  own directories. Returns the number of migrated keys.
+func MigrateLegacyKeyStore() (int, error) {
+	migrated := 0
+	for name, entry := range storage.Internal.GetKeys() {
+		if err := ValidateKeyName(name); err != nil {
+			return migrated, err
+		}
+		entry.Name = name
+
+		// Keypair files stored prior to versions belong to the first version, the
+		//  key's directory taking their place
+		legacyPath := path.Join(KeyStorePath, name)
+		if info, err := os.Stat(legacyPath); err == nil && !info.IsDir() {
+			versionPath := path.Join(KeyStorePath, VersionKeyName(name, 1))
+			if err := os.Rename(legacyPath, versionPath); err != nil {
+				return migrated, err
+			}
+			if err := os.Rename(legacyPath+".pub", versionPath+".pub"); err != nil && !os.IsNotExist(err) {
+				return migrated, err
+			}
+		}
+		if err := os.MkdirAll(KeyDir(name), 0700); err != nil {
+			return migrated, err
+		}
+
+		for _, keyVersion := range entry.Versions {
+			flatPath := path.Join(KeyStorePath, VersionKeyName(name, keyVersion.Version))
+			versionPath := path.Join(KeyDir(name), versionFileName(keyVersion.Version))
+			for _, suffix := range []string{"", ".pub"} {
+				if err := os.Rename(flatPath+suffix, versionPath+suffix); err != nil && !os.IsNotExist(err) {
+					return migrated, err
+				}
+			}
+		}
+		if err := SaveKey(entry); err != nil {
+			return migrated, err
+		}
+		migrated += 1
+	}
+	return migrated, nil
+}
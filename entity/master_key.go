@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Master key derivation functions
+const (
+	MasterKeyKdf_File     = "file"     // Random master key read from a key file
+	MasterKeyKdf_Argon2id = "argon2id" // Master key derived from an operator passphrase
+	MasterKeyKdf_Shamir   = "shamir"   // Master key split into unseal shares held by operators
+)
+
+// Default Argon2id parameters used to derive the master key from a passphrase
+const (
+	Argon2Time    = uint32(3)
+	Argon2Memory  = uint32(64 * 1024) // KiB
+	Argon2Threads = uint8(4)
+)
+
+// Prefix of values sealed using the master key, distinguishing them from values
+//  stored prior to the master key
+const sealedValuePrefix = "sealed:"
+
+// Known value sealed using the master key, verifying the right master key was
+//  provided prior to unsealing anything
+var masterKeyCheckValue = []byte("openabyss master key")
+
+var (
+	// Master key sealing private keys & raw cipher keys at rest, only ever held
+	//  in memory
+	masterKey []byte
+
+	// Returned when sealing or unsealing without a master key
+	ErrNoMasterKey = errors.New("master key not available")
+)
+
+// Sets the master key used to seal & unseal key material
+func SetMasterKey(key []byte) error {
+	if len(key) != DataKeySize {
+		return errors.New("invalid master key size")
+	}
+	masterKey = append([]byte{}, key...)
+	return nil
+}
+
+// Clears the master key from memory, sealing & unsealing being unavailable until
+//  it's set again
+func ClearMasterKey() {
+	for idx := range masterKey {
+		masterKey[idx] = 0
+	}
+	masterKey = nil
+}
+
+// Whether the master key is available to seal & unseal key material
+func HasMasterKey() bool {
+	return masterKey != nil
+}
+
+// Generates a random master key, stored in a key file
+func GenerateMasterKey() ([]byte, error) {
+	return GenerateDataKey()
+}
+
+// Derives the master key from the passphrase & salt (Argon2id)
+func DeriveMasterKey(passphrase []byte, salt []byte, time uint32, memory uint32, threads uint8) []byte {
+	return argon2.IDKey(passphrase, salt, time, memory, threads, DataKeySize)
+}
+
+// Creates the check value of the master key, verified using VerifyMasterKey
+func MasterKeyCheck() (string, error) {
+	return SealString(string(masterKeyCheckValue))
+}
+
+// Verifies the master key is the one that created the check value
+func VerifyMasterKey(check string) bool {
+	value, err := UnsealString(check)
+	return err == nil && IsSealedString(check) && value == string(masterKeyCheckValue)
+}
+
+// Splits the master key into unseal shares, any threshold number of them recovering
+//  it using UnsealMasterKey
+func SplitMasterKey(shares int, threshold int) ([][]byte, error) {
+	if !HasMasterKey() {
+		return nil, ErrNoMasterKey
+	}
+	return SplitSecret(masterKey, shares, threshold)
+}
+
+// Recovers the master key from the unseal shares, setting it if verified by the check
+//  value
+func UnsealMasterKey(shares [][]byte, check string) error {
+	key, err := CombineShares(shares)
+	if err != nil {
+		return err
+	}
+	if err := SetMasterKey(key); err != nil {
+		return err
+	}
+	if !VerifyMasterKey(check) {
+		ClearMasterKey()
+		return errors.New("invalid master key")
+	}
+	return nil
+}
+
+// Seals given data using the master key (AES-256-GCM), prepending the random nonce
+//  to the sealed data
+func Seal(data []byte) ([]byte, error) {
+	if !HasMasterKey() {
+		return nil, ErrNoMasterKey
+	}
+	c, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return CipherSeal(c, data)
+}
+
+// Unseals given data, sealed using Seal, with the master key. Returns ErrIntegrity
+//  if the data was tampered with or sealed using another master key.
+func Unseal(sealed []byte) ([]byte, error) {
+	if !HasMasterKey() {
+		return nil, ErrNoMasterKey
+	}
+	c, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return CipherOpen(c, sealed)
+}
+
+// Whether the stored value was sealed using SealString
+func IsSealedString(value string) bool {
+	return strings.HasPrefix(value, sealedValuePrefix)
+}
+
+// Seals the stored value using the master key, encoded as a prefixed base64 string.
+//  Empty and already sealed values are returned as is.
+func SealString(value string) (string, error) {
+	if value == "" || IsSealedString(value) {
+		return value, nil
+	}
+	sealed, err := Seal([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return sealedValuePrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Unseals the stored value, sealed using SealString. Values stored prior to the
+//  master key are returned as is.
+func UnsealString(value string) (string, error) {
+	if !IsSealedString(value) {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedValuePrefix))
+	if err != nil {
+		return "", ErrIntegrity
+	}
+	data, err := Unseal(sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Generates a random salt used to derive the master key
+func GenerateMasterKeySalt() ([]byte, error) {
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Magic bytes of data sealed using a passphrase
+var passphraseMagic = []byte("OAPS")
+
+// Version of the passphrase sealed data format
+const passphraseVersion = uint8(1)
+
+// Size in bytes of the passphrase sealed header: magic, version, Argon2id time, memory
+//  & threads, salt and nonce
+const passphraseHeaderSize = 4 + 1 + 4 + 4 + 1 + 16 + chacha20poly1305.NonceSizeX
+
+// Whether the data was sealed using SealWithPassphrase
+func IsPassphraseSealed(data []byte) bool {
+	return bytes.HasPrefix(data, passphraseMagic)
+}
+
+// Seals the data using a key derived from the passphrase (Argon2id), encrypted using
+//  XChaCha20-Poly1305. The header, holding the derivation parameters, salt & nonce, is
+//  authenticated along with the data.
+func SealWithPassphrase(data []byte, passphrase []byte) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase cannot be empty")
+	}
+
+	header := bytes.NewBuffer(make([]byte, 0, passphraseHeaderSize))
+	header.Write(passphraseMagic)
+	header.WriteByte(passphraseVersion)
+	binary.Write(header, binary.BigEndian, Argon2Time)
+	binary.Write(header, binary.BigEndian, Argon2Memory)
+	header.WriteByte(Argon2Threads)
+
+	saltNonce := make([]byte, 16+chacha20poly1305.NonceSizeX)
+	if _, err := io.ReadFull(rand.Reader, saltNonce); err != nil {
+		return nil, err
+	}
+	header.Write(saltNonce)
+
+	aead, err := chacha20poly1305.NewX(argon2.IDKey(passphrase, saltNonce[:16], Argon2Time, Argon2Memory, Argon2Threads, chacha20poly1305.KeySize))
+	if err != nil {
+		return nil, err
+	}
+	headerBuffer := header.Bytes()
+	return aead.Seal(headerBuffer, saltNonce[16:], data, headerBuffer), nil
+}
+
+// Opens data sealed using SealWithPassphrase. Returns ErrIntegrity if the passphrase
+//  is wrong or the data was tampered with. Data sealed using costlier derivation
+//  parameters than Argon2Time, Argon2Memory & Argon2Threads is refused.
+func OpenWithPassphrase(sealed []byte, passphrase []byte) ([]byte, error) {
+	if !IsPassphraseSealed(sealed) || len(sealed) < passphraseHeaderSize {
+		return nil, errors.New("data not sealed using a passphrase")
+	}
+	if sealed[4] != passphraseVersion {
+		return nil, errors.New("passphrase sealed data version not supported")
+	}
+
+	time := binary.BigEndian.Uint32(sealed[5:9])
+	memory := binary.BigEndian.Uint32(sealed[9:13])
+	threads := sealed[13]
+	salt := sealed[14:30]
+	nonce := sealed[30:passphraseHeaderSize]
+	// Derivation parameters are read from untrusted data, refuse costlier ones than
+	//  the server's own to bound the work done per open
+	if time == 0 || time > Argon2Time || memory == 0 || memory > Argon2Memory || threads == 0 || threads > Argon2Threads {
+		return nil, errors.New("invalid passphrase derivation parameters")
+	}
+
+	aead, err := chacha20poly1305.NewX(argon2.IDKey(passphrase, salt, time, memory, threads, chacha20poly1305.KeySize))
+	if err != nil {
+		return nil, err
+	}
+	data, err := aead.Open(nil, nonce, sealed[passphraseHeaderSize:], sealed[:passphraseHeaderSize])
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+	return data, nil
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Standard public key formats
+const (
+	PublicKeyFormat_PEM = "pem" // PKIX (SubjectPublicKeyInfo) PEM
+	PublicKeyFormat_JWK = "jwk" // JSON Web Key
+	PublicKeyFormat_SSH = "ssh" // OpenSSH authorized_keys line
+)
+
+// Parses the PEM encoded public key of any key algorithm: PKCS#1 & PKIX keys, along
+//  with hybrid keys encoded using MarshalHybridPublicKey
+func ParsePublicKeyPem(rawKey []byte) (crypto.PublicKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	switch decodedKey.Type {
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(decodedKey.Bytes)
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(decodedKey.Bytes)
+	case hybridPublicKeyPemType:
+		return ParseHybridPublicKey(rawKey)
+	default:
+		return nil, errors.New("pem type '" + decodedKey.Type + "' not supported")
+	}
+}
+
+// Encodes the public key in the given standard format. Hybrid keys have no standard
+//  encoding and are only encoded as their PEM.
+func MarshalPublicKey(pk crypto.PublicKey, format string) ([]byte, error) {
+	if hybridPk, ok := pk.(*HybridPublicKey); ok {
+		if format != PublicKeyFormat_PEM {
+			return nil, errors.New("hybrid keys are only encoded as pem")
+		}
+		return MarshalHybridPublicKey(hybridPk), nil
+	}
+
+	switch format {
+	case PublicKeyFormat_PEM:
+		pkDer, err := x509.MarshalPKIXPublicKey(pk)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkDer}), nil
+	case PublicKeyFormat_JWK:
+		return marshalPublicJWK(pk)
+	case PublicKeyFormat_SSH:
+		switch pk.(type) {
+		case *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			return nil, errors.New("ssh format only supports rsa & ed25519 keys")
+		}
+		sshPk, err := ssh.NewPublicKey(pk)
+		if err != nil {
+			return nil, err
+		}
+		return ssh.MarshalAuthorizedKey(sshPk), nil
+	default:
+		return nil, errors.New("public key format '" + format + "' not supported")
+	}
+}
+
+// Computes the hex SHA-256 fingerprint of the public key's DER SubjectPublicKeyInfo.
+//  Hybrid keys, having no SubjectPublicKeyInfo, are fingerprinted over their encoding.
+func PublicKeyFingerprint(pk crypto.PublicKey) (string, error) {
+	var pkDer []byte
+	if hybridPk, ok := pk.(*HybridPublicKey); ok {
+		pkDer = hybridPk.Bytes()
+	} else {
+		var err error
+		if pkDer, err = x509.MarshalPKIXPublicKey(pk); err != nil {
+			return "", err
+		}
+	}
+	sum := sha256.Sum256(pkDer)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Encodes the public key as a JSON Web Key (RFC 7517, 7518, 8037)
+func marshalPublicJWK(pk crypto.PublicKey) ([]byte, error) {
+	encode := base64.RawURLEncoding.EncodeToString
+	var jwk map[string]string
+	switch k := pk.(type) {
+	case *rsa.PublicKey:
+		jwk = map[string]string{"kty": "RSA", "n": encode(k.N.Bytes()), "e": encode(big.NewInt(int64(k.E)).Bytes())}
+	case ed25519.PublicKey:
+		jwk = map[string]string{"kty": "OKP", "crv": "Ed25519", "x": encode(k)}
+	case *ecdh.PublicKey:
+		if k.Curve() != ecdh.X25519() {
+			return nil, errors.New("ecdh curve not supported")
+		}
+		jwk = map[string]string{"kty": "OKP", "crv": "X25519", "x": encode(k.Bytes())}
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().Name != "P-256" {
+			return nil, errors.New("ec curve not supported")
+		}
+		ecdhPk, err := k.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		pkBytes := ecdhPk.Bytes() // Uncompressed point: 0x04 | x | y
+		jwk = map[string]string{"kty": "EC", "crv": "P-256", "x": encode(pkBytes[1:33]), "y": encode(pkBytes[33:])}
+	default:
+		return nil, errors.New("key type not supported")
+	}
+	return json.Marshal(jwk)
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+)
+
+// Supported RSA-OAEP hash algorithms
+const (
+	OAEPHash_SHA256 = "sha256"
+	OAEPHash_SHA384 = "sha384"
+	OAEPHash_SHA512 = "sha512"
+)
+
+var (
+	// Hash used by keys generated prior to the OAEP hash being selectable
+	DefaultOAEPHash = OAEPHash_SHA256
+
+	// Supported RSA key sizes in bits
+	RSAKeySizes = []int{2048, 3072, 4096}
+)
+
+// Constructs the RSA-OAEP hash by name, defaulting to DefaultOAEPHash if empty
+func newOAEPHash(name string) (hash.Hash, error) {
+	switch name {
+	case "":
+		return newOAEPHash(DefaultOAEPHash)
+	case OAEPHash_SHA256:
+		return sha256.New(), nil
+	case OAEPHash_SHA384:
+		return sha512.New384(), nil
+	case OAEPHash_SHA512:
+		return sha512.New(), nil
+	default:
+		return nil, errors.New("oaep hash '" + name + "' not supported")
+	}
+}
+
+// Validates the RSA-OAEP hash is supported
+func ValidateOAEPHash(name string) error {
+	_, err := newOAEPHash(name)
+	return err
+}
+
+// Validates the RSA key size is supported
+func ValidateRSAKeySize(bits int) error {
+	for _, size := range RSAKeySizes {
+		if bits == size {
+			return nil
+		}
+	}
+	return errors.New("rsa key size not supported")
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// Splits the secret into the given number of shares using Shamir's secret sharing
+//  over GF(2^8), any threshold number of them recovering the secret. Each share holds
+//  the evaluated bytes followed by its non-zero x coordinate.
+func SplitSecret(secret []byte, shares int, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("secret cannot be empty")
+	}
+	if threshold < 1 || shares < threshold || shares > 255 {
+		return nil, errors.New("invalid shares & threshold, 1 <= threshold <= shares <= 255")
+	}
+
+	result := make([][]byte, shares)
+	for idx := range result {
+		result[idx] = make([]byte, len(secret)+1)
+		result[idx][len(secret)] = byte(idx + 1)
+	}
+
+	// Random polynomial of degree threshold-1 per secret byte, being its constant term
+	coefficients := make([]byte, threshold)
+	for byteIdx, secretByte := range secret {
+		coefficients[0] = secretByte
+		if _, err := io.ReadFull(rand.Reader, coefficients[1:]); err != nil {
+			return nil, err
+		}
+		for _, share := range result {
+			share[byteIdx] = gfEvaluate(coefficients, share[len(secret)])
+		}
+	}
+	return result, nil
+}
+
+// Combines shares created using SplitSecret, recovering the secret. Combining fewer
+//  shares than the threshold results in an unrelated secret.
+func CombineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares provided")
+	}
+	shareLength := len(shares[0])
+	if shareLength < 2 {
+		return nil, errors.New("invalid share")
+	}
+
+	xs := make([]byte, len(shares))
+	for idx, share := range shares {
+		if len(share) != shareLength {
+			return nil, errors.New("shares differ in length")
+		}
+		xs[idx] = share[shareLength-1]
+		if xs[idx] == 0 {
+			return nil, errors.New("invalid share")
+		}
+		for _, x := range xs[:idx] {
+			if x == xs[idx] {
+				return nil, errors.New("duplicate share")
+			}
+		}
+	}
+
+	// Lagrange interpolation at x=0 of every secret byte
+	secret := make([]byte, shareLength-1)
+	for idx, share := range shares {
+		basis := byte(1)
+		for otherIdx, x := range xs {
+			if otherIdx != idx {
+				basis = gfMul(basis, gfDiv(x, x^xs[idx]))
+			}
+		}
+		for byteIdx := range secret {
+			secret[byteIdx] ^= gfMul(share[byteIdx], basis)
+		}
+	}
+	return secret, nil
+}
+
+// Evaluates the polynomial with the given coefficients at x (Horner's method)
+func gfEvaluate(coefficients []byte, x byte) byte {
+	result := byte(0)
+	for idx := len(coefficients) - 1; idx >= 0; idx-- {
+		result = gfMul(result, x) ^ coefficients[idx]
+	}
+	return result
+}
+
+// Multiplies within GF(2^8), reduced by the AES polynomial
+func gfMul(a byte, b byte) byte {
+	result := byte(0)
+	for b > 0 {
+		if b&1 == 1 {
+			result ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return result
+}
+
+// Divides within GF(2^8), multiplying by the inverse of b (b^254)
+func gfDiv(a byte, b byte) byte {
+	inverse := byte(1)
+	for i := 0; i < 254; i++ {
+		inverse = gfMul(inverse, b)
+	}
+	return gfMul(a, inverse)
+}
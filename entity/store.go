@@ -1,22 +1,30 @@
 package entity
 
+import (
+	"strings"
+	"sync"
+)
+
 type EntityStore struct {
-	Keys   map[string]Entity
-	Length int64
+	Keys  map[string]Entity
+	mutex sync.RWMutex
 }
 
 /**
  * Adds a key to the entity store
  */
 func (entityStore *EntityStore) Add(elt Entity) {
+	entityStore.mutex.Lock()
+	defer entityStore.mutex.Unlock()
 	entityStore.Keys[elt.Name] = elt
-	entityStore.Length++
 }
 
 /**
  * Return entity given entity's name
  */
 func (entityStore *EntityStore) Get(keyName string) *Entity {
+	entityStore.mutex.RLock()
+	defer entityStore.mutex.RUnlock()
 	entity := entityStore.Keys[keyName]
 	return &entity
 }
@@ -25,6 +33,61 @@ func (entityStore *EntityStore) Get(keyName string) *Entity {
  * Checks if the given keyname exists in store
  */
 func (entityStore *EntityStore) Has(keyName string) bool {
+	entityStore.mutex.RLock()
+	defer entityStore.mutex.RUnlock()
 	_, ok := entityStore.Keys[keyName]
 	return ok
 }
+
+/**
+ * Removes the given key name from the entity store
+ */
+func (entityStore *EntityStore) Remove(keyName string) {
+	entityStore.mutex.Lock()
+	defer entityStore.mutex.Unlock()
+	delete(entityStore.Keys, keyName)
+}
+
+/**
+ * Renames the given key name within the entity store, if stored
+ */
+func (entityStore *EntityStore) Rename(keyName string, newName string) {
+	entityStore.mutex.Lock()
+	defer entityStore.mutex.Unlock()
+	if e, ok := entityStore.Keys[keyName]; ok {
+		e.Name = newName
+		entityStore.Keys[newName] = e
+		delete(entityStore.Keys, keyName)
+	}
+}
+
+/**
+ * Removes the entries of every version of the given key name
+ */
+func (entityStore *EntityStore) RemoveVersions(keyName string) {
+	entityStore.mutex.Lock()
+	defer entityStore.mutex.Unlock()
+	for storeName := range entityStore.Keys {
+		if strings.HasPrefix(storeName, keyName+".v") {
+			delete(entityStore.Keys, storeName)
+		}
+	}
+}
+
+/**
+ * Removes every key from the entity store
+ */
+func (entityStore *EntityStore) Clear() {
+	entityStore.mutex.Lock()
+	defer entityStore.mutex.Unlock()
+	entityStore.Keys = make(map[string]Entity)
+}
+
+/**
+ * Returns the number of keys within the entity store
+ */
+func (entityStore *EntityStore) Len() int {
+	entityStore.mutex.RLock()
+	defer entityStore.mutex.RUnlock()
+	return len(entityStore.Keys)
+}
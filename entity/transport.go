@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/ecdh"
+	"encoding/binary"
+	"errors"
+)
+
+// Magic bytes of data sealed for a transport key
+var transportMagic = []byte("OATK")
+
+// Version of the transport sealed data format
+const transportVersion = uint8(1)
+
+// Whether the data was sealed using SealForTransport
+func IsTransportSealed(data []byte) bool {
+	return bytes.HasPrefix(data, transportMagic)
+}
+
+// Generates a transport keypair, receiving data sealed for its public key by other
+//  servers
+func GenerateTransportKey() (*ecdh.PrivateKey, error) {
+	return GenerateECDHKey(ECDH_X25519)
+}
+
+// Seals the data for the PKIX PEM encoded X25519 transport public key. The data is
+//  encrypted using a random data key (AES-256-GCM), wrapped to the transport key
+//  using ECDHWrapDataKey.
+func SealForTransport(data []byte, transportPublicKeyPem []byte) ([]byte, error) {
+	pk, err := ParseECDHPublicKey(ECDH_X25519, transportPublicKeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := ECDHWrapDataKey(pk, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	sealedData, err := CipherSeal(c, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Magic | Version | Wrapped key length | Wrapped key | Sealed data
+	buffer := bytes.NewBuffer(nil)
+	buffer.Write(transportMagic)
+	buffer.WriteByte(transportVersion)
+	binary.Write(buffer, binary.BigEndian, uint16(len(wrappedKey)))
+	buffer.Write(wrappedKey)
+	buffer.Write(sealedData)
+	return buffer.Bytes(), nil
+}
+
+// Opens data sealed using SealForTransport with the transport private key. Returns
+//  ErrIntegrity if the data was tampered with or sealed for another transport key.
+func OpenTransport(sealed []byte, sk *ecdh.PrivateKey) ([]byte, error) {
+	if !IsTransportSealed(sealed) || len(sealed) < 7 {
+		return nil, errors.New("data not sealed for transport")
+	}
+	if sealed[4] != transportVersion {
+		return nil, errors.New("transport sealed data version not supported")
+	}
+	wrappedKeyLength := int(binary.BigEndian.Uint16(sealed[5:7]))
+	if len(sealed) < 7+wrappedKeyLength {
+		return nil, ErrIntegrity
+	}
+
+	dataKey, err := ECDHUnwrapDataKey(sk, sealed[7:7+wrappedKeyLength])
+	if err != nil {
+		return nil, err
+	}
+	c, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return CipherOpen(c, sealed[7+wrappedKeyLength:])
+}
@@ -108,6 +108,7 @@ type FileOptions struct {
 	Overwrite   bool   `protobuf:"varint,1,opt,name=Overwrite,proto3" json:"Overwrite,omitempty"`
 	StoragePath string `protobuf:"bytes,2,opt,name=StoragePath,proto3" json:"StoragePath,omitempty"`
 	KeyName     string `protobuf:"bytes,3,opt,name=KeyName,proto3" json:"KeyName,omitempty"`
+	Compressed  bool   `protobuf:"varint,4,opt,name=Compressed,proto3" json:"Compressed,omitempty"` // File bytes are gzip compressed
 }
 
 func (x *FileOptions) Reset() {
@@ -163,13 +164,20 @@ func (x *FileOptions) GetKeyName() string {
 	return ""
 }
 
+func (x *FileOptions) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
 type DecryptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	FilePath          string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	KeyName           []byte `protobuf:"bytes,2,opt,name=KeyName,proto3" json:"KeyName,omitempty"`
+	KeyName           []byte `protobuf:"bytes,2,opt,name=KeyName,proto3" json:"KeyName,omitempty"`                     // Optional, defaults to the key that encrypted the file
 	FilePathSignature []byte `protobuf:"bytes,3,opt,name=FilePathSignature,proto3" json:"FilePathSignature,omitempty"` // Used for verifying signature if one is required
 }
 
@@ -226,17 +234,19 @@ func (x *DecryptRequest) GetFilePathSignature() []byte {
 	return nil
 }
 
-type EncryptResult struct {
+// ENCRYPT/DECRYPT: STREAM
+type FileStreamHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileStoragePath string `protobuf:"bytes,1,opt,name=FileStoragePath,proto3" json:"FileStoragePath,omitempty"`
-	FileId          string `protobuf:"bytes,2,opt,name=FileId,proto3" json:"FileId,omitempty"`
+	SizeInBytes int64        `protobuf:"varint,1,opt,name=SizeInBytes,proto3" json:"SizeInBytes,omitempty"`
+	FileName    string       `protobuf:"bytes,2,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	Options     *FileOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *EncryptResult) Reset() {
-	*x = EncryptResult{}
+func (x *FileStreamHeader) Reset() {
+	*x = FileStreamHeader{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +254,13 @@ func (x *EncryptResult) Reset() {
 	}
 }
 
-func (x *EncryptResult) String() string {
+func (x *FileStreamHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptResult) ProtoMessage() {}
+func (*FileStreamHeader) ProtoMessage() {}
 
-func (x *EncryptResult) ProtoReflect() protoreflect.Message {
+func (x *FileStreamHeader) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,37 +272,46 @@ func (x *EncryptResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptResult.ProtoReflect.Descriptor instead.
-func (*EncryptResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileStreamHeader.ProtoReflect.Descriptor instead.
+func (*FileStreamHeader) Descriptor() ([]byte, []int) {
 	return file_server_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EncryptResult) GetFileStoragePath() string {
+func (x *FileStreamHeader) GetSizeInBytes() int64 {
 	if x != nil {
-		return x.FileStoragePath
+		return x.SizeInBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *EncryptResult) GetFileId() string {
+func (x *FileStreamHeader) GetFileName() string {
 	if x != nil {
-		return x.FileId
+		return x.FileName
 	}
 	return ""
 }
 
-// MOD
-type EntityMod struct {
+func (x *FileStreamHeader) GetOptions() *FileOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type FileStreamPacket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilePath string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Remove   bool   `protobuf:"varint,2,opt,name=Remove,proto3" json:"Remove,omitempty"`
+	// Types that are assignable to Packet:
+	//	*FileStreamPacket_Header
+	//	*FileStreamPacket_Chunk
+	//	*FileStreamPacket_FileSignature
+	Packet isFileStreamPacket_Packet `protobuf_oneof:"Packet"`
 }
 
-func (x *EntityMod) Reset() {
-	*x = EntityMod{}
+func (x *FileStreamPacket) Reset() {
+	*x = FileStreamPacket{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,13 +319,13 @@ func (x *EntityMod) Reset() {
 	}
 }
 
-func (x *EntityMod) String() string {
+func (x *FileStreamPacket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityMod) ProtoMessage() {}
+func (*FileStreamPacket) ProtoMessage() {}
 
-func (x *EntityMod) ProtoReflect() protoreflect.Message {
+func (x *FileStreamPacket) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,44 +337,72 @@ func (x *EntityMod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityMod.ProtoReflect.Descriptor instead.
-func (*EntityMod) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileStreamPacket.ProtoReflect.Descriptor instead.
+func (*FileStreamPacket) Descriptor() ([]byte, []int) {
 	return file_server_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EntityMod) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
+func (m *FileStreamPacket) GetPacket() isFileStreamPacket_Packet {
+	if m != nil {
+		return m.Packet
 	}
-	return ""
+	return nil
 }
 
-func (x *EntityMod) GetRemove() bool {
-	if x != nil {
-		return x.Remove
+func (x *FileStreamPacket) GetHeader() *FileStreamHeader {
+	if x, ok := x.GetPacket().(*FileStreamPacket_Header); ok {
+		return x.Header
 	}
-	return false
+	return nil
 }
 
-// ENTITY
-type Entity struct {
+func (x *FileStreamPacket) GetChunk() []byte {
+	if x, ok := x.GetPacket().(*FileStreamPacket_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *FileStreamPacket) GetFileSignature() []byte {
+	if x, ok := x.GetPacket().(*FileStreamPacket_FileSignature); ok {
+		return x.FileSignature
+	}
+	return nil
+}
+
+type isFileStreamPacket_Packet interface {
+	isFileStreamPacket_Packet()
+}
+
+type FileStreamPacket_Header struct {
+	Header *FileStreamHeader `protobuf:"bytes,1,opt,name=Header,proto3,oneof"` // First packet of the stream
+}
+
+type FileStreamPacket_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=Chunk,proto3,oneof"` // File chunk following the header
+}
+
+type FileStreamPacket_FileSignature struct {
+	FileSignature []byte `protobuf:"bytes,3,opt,name=FileSignature,proto3,oneof"` // Last packet, signature of the streamed chunks' SHA256 digest if one is required
+}
+
+func (*FileStreamPacket_Header) isFileStreamPacket_Packet() {}
+
+func (*FileStreamPacket_Chunk) isFileStreamPacket_Packet() {}
+
+func (*FileStreamPacket_FileSignature) isFileStreamPacket_Packet() {}
+
+type EncryptResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description            string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	PublicKeyName          []byte `protobuf:"bytes,3,opt,name=PublicKeyName,proto3" json:"PublicKeyName,omitempty"`
-	Algorithm              string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
-	CreatedUnixTimestamp   uint64 `protobuf:"varint,5,opt,name=CreatedUnixTimestamp,proto3" json:"CreatedUnixTimestamp,omitempty"`
-	ModifiedUnixTimestamp  uint64 `protobuf:"varint,6,opt,name=ModifiedUnixTimestamp,proto3" json:"ModifiedUnixTimestamp,omitempty"`
-	ExpiresAtUnixTimestamp uint64 `protobuf:"varint,7,opt,name=ExpiresAtUnixTimestamp,proto3" json:"ExpiresAtUnixTimestamp,omitempty"`
-	SigningPrivateKeySeed  string `protobuf:"bytes,8,opt,name=SigningPrivateKeySeed,proto3" json:"SigningPrivateKeySeed,omitempty"`
-	SigningPublicKeyPem    string `protobuf:"bytes,9,opt,name=SigningPublicKeyPem,proto3" json:"SigningPublicKeyPem,omitempty"`
+	FileStoragePath string `protobuf:"bytes,1,opt,name=FileStoragePath,proto3" json:"FileStoragePath,omitempty"`
+	FileId          string `protobuf:"bytes,2,opt,name=FileId,proto3" json:"FileId,omitempty"`
 }
 
-func (x *Entity) Reset() {
-	*x = Entity{}
+func (x *EncryptResult) Reset() {
+	*x = EncryptResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +410,13 @@ func (x *Entity) Reset() {
 	}
 }
 
-func (x *Entity) String() string {
+func (x *EncryptResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Entity) ProtoMessage() {}
+func (*EncryptResult) ProtoMessage() {}
 
-func (x *Entity) ProtoReflect() protoreflect.Message {
+func (x *EncryptResult) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,103 +428,108 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
-func (*Entity) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptResult.ProtoReflect.Descriptor instead.
+func (*EncryptResult) Descriptor() ([]byte, []int) {
 	return file_server_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Entity) GetName() string {
+func (x *EncryptResult) GetFileStoragePath() string {
 	if x != nil {
-		return x.Name
+		return x.FileStoragePath
 	}
 	return ""
 }
 
-func (x *Entity) GetDescription() string {
+func (x *EncryptResult) GetFileId() string {
 	if x != nil {
-		return x.Description
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *Entity) GetPublicKeyName() []byte {
-	if x != nil {
-		return x.PublicKeyName
-	}
-	return nil
+// MOD
+type EntityMod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePath string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Remove   bool   `protobuf:"varint,2,opt,name=Remove,proto3" json:"Remove,omitempty"`
 }
 
-func (x *Entity) GetAlgorithm() string {
-	if x != nil {
-		return x.Algorithm
+func (x *EntityMod) Reset() {
+	*x = EntityMod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Entity) GetCreatedUnixTimestamp() uint64 {
-	if x != nil {
-		return x.CreatedUnixTimestamp
-	}
-	return 0
+func (x *EntityMod) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Entity) GetModifiedUnixTimestamp() uint64 {
-	if x != nil {
-		return x.ModifiedUnixTimestamp
+func (*EntityMod) ProtoMessage() {}
+
+func (x *EntityMod) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Entity) GetExpiresAtUnixTimestamp() uint64 {
-	if x != nil {
-		return x.ExpiresAtUnixTimestamp
-	}
-	return 0
+// Deprecated: Use EntityMod.ProtoReflect.Descriptor instead.
+func (*EntityMod) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Entity) GetSigningPrivateKeySeed() string {
+func (x *EntityMod) GetFilePath() string {
 	if x != nil {
-		return x.SigningPrivateKeySeed
+		return x.FilePath
 	}
 	return ""
 }
 
-func (x *Entity) GetSigningPublicKeyPem() string {
+func (x *EntityMod) GetRemove() bool {
 	if x != nil {
-		return x.SigningPublicKeyPem
+		return x.Remove
 	}
-	return ""
+	return false
 }
 
-type EntityModifyRequest struct {
+type CipherMigrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description            string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	KeyId                  string `protobuf:"bytes,3,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
-	ModifyKeyExpiration    bool   `protobuf:"varint,4,opt,name=ModifyKeyExpiration,proto3" json:"ModifyKeyExpiration,omitempty"`
-	ExpiresInUnixTimestamp uint64 `protobuf:"varint,5,opt,name=ExpiresInUnixTimestamp,proto3" json:"ExpiresInUnixTimestamp,omitempty"`
+	KeyName       string `protobuf:"bytes,1,opt,name=KeyName,proto3" json:"KeyName,omitempty"`             // Key the stored files were encrypted with
+	Path          string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`                   // Internal path to recursively migrate
+	PathSignature []byte `protobuf:"bytes,3,opt,name=PathSignature,proto3" json:"PathSignature,omitempty"` // Used for verifying signature if one is required
 }
 
-func (x *EntityModifyRequest) Reset() {
-	*x = EntityModifyRequest{}
+func (x *CipherMigrationRequest) Reset() {
+	*x = CipherMigrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[6]
+		mi := &file_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EntityModifyRequest) String() string {
+func (x *CipherMigrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityModifyRequest) ProtoMessage() {}
+func (*CipherMigrationRequest) ProtoMessage() {}
 
-func (x *EntityModifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[6]
+func (x *CipherMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,71 +540,58 @@ func (x *EntityModifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityModifyRequest.ProtoReflect.Descriptor instead.
-func (*EntityModifyRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *EntityModifyRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use CipherMigrationRequest.ProtoReflect.Descriptor instead.
+func (*CipherMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EntityModifyRequest) GetDescription() string {
+func (x *CipherMigrationRequest) GetKeyName() string {
 	if x != nil {
-		return x.Description
+		return x.KeyName
 	}
 	return ""
 }
 
-func (x *EntityModifyRequest) GetKeyId() string {
+func (x *CipherMigrationRequest) GetPath() string {
 	if x != nil {
-		return x.KeyId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *EntityModifyRequest) GetModifyKeyExpiration() bool {
-	if x != nil {
-		return x.ModifyKeyExpiration
-	}
-	return false
-}
-
-func (x *EntityModifyRequest) GetExpiresInUnixTimestamp() uint64 {
+func (x *CipherMigrationRequest) GetPathSignature() []byte {
 	if x != nil {
-		return x.ExpiresInUnixTimestamp
+		return x.PathSignature
 	}
-	return 0
+	return nil
 }
 
-type EntityRemoveRequest struct {
+type CipherMigrationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyId string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	MigratedPaths []string `protobuf:"bytes,1,rep,name=MigratedPaths,proto3" json:"MigratedPaths,omitempty"`
+	FailedPaths   []string `protobuf:"bytes,2,rep,name=FailedPaths,proto3" json:"FailedPaths,omitempty"`
 }
 
-func (x *EntityRemoveRequest) Reset() {
-	*x = EntityRemoveRequest{}
+func (x *CipherMigrationResponse) Reset() {
+	*x = CipherMigrationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[7]
+		mi := &file_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EntityRemoveRequest) String() string {
+func (x *CipherMigrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityRemoveRequest) ProtoMessage() {}
+func (*CipherMigrationResponse) ProtoMessage() {}
 
-func (x *EntityRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[7]
+func (x *CipherMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,46 +602,69 @@ func (x *EntityRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityRemoveRequest.ProtoReflect.Descriptor instead.
-func (*EntityRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CipherMigrationResponse.ProtoReflect.Descriptor instead.
+func (*CipherMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EntityRemoveRequest) GetKeyId() string {
+func (x *CipherMigrationResponse) GetMigratedPaths() []string {
 	if x != nil {
-		return x.KeyId
+		return x.MigratedPaths
 	}
-	return ""
+	return nil
 }
 
-type GenerateEntityRequest struct {
+func (x *CipherMigrationResponse) GetFailedPaths() []string {
+	if x != nil {
+		return x.FailedPaths
+	}
+	return nil
+}
+
+// ENTITY
+type Entity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description            string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	Algorithm              string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
-	ExpiresInUnixTimestamp uint64 `protobuf:"varint,4,opt,name=ExpiresInUnixTimestamp,proto3" json:"ExpiresInUnixTimestamp,omitempty"`
+	Name                   string                      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description            string                      `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	PublicKeyName          []byte                      `protobuf:"bytes,3,opt,name=PublicKeyName,proto3" json:"PublicKeyName,omitempty"`
+	Algorithm              string                      `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	CreatedUnixTimestamp   uint64                      `protobuf:"varint,5,opt,name=CreatedUnixTimestamp,proto3" json:"CreatedUnixTimestamp,omitempty"`
+	ModifiedUnixTimestamp  uint64                      `protobuf:"varint,6,opt,name=ModifiedUnixTimestamp,proto3" json:"ModifiedUnixTimestamp,omitempty"`
+	ExpiresAtUnixTimestamp uint64                      `protobuf:"varint,7,opt,name=ExpiresAtUnixTimestamp,proto3" json:"ExpiresAtUnixTimestamp,omitempty"`
+	SigningPrivateKeySeed  string                      `protobuf:"bytes,8,opt,name=SigningPrivateKeySeed,proto3" json:"SigningPrivateKeySeed,omitempty"`
+	SigningPublicKeyPem    string                      `protobuf:"bytes,9,opt,name=SigningPublicKeyPem,proto3" json:"SigningPublicKeyPem,omitempty"`
+	Versions               []*EntityKeyVersion         `protobuf:"bytes,10,rep,name=Versions,proto3" json:"Versions,omitempty"` // Ordered from oldest to latest
+	KeySize                uint32                      `protobuf:"varint,11,opt,name=KeySize,proto3" json:"KeySize,omitempty"`
+	OAEPHash               string                      `protobuf:"bytes,12,opt,name=OAEPHash,proto3" json:"OAEPHash,omitempty"`
+	CipherAlgorithm        string                      `protobuf:"bytes,13,opt,name=CipherAlgorithm,proto3" json:"CipherAlgorithm,omitempty"`
+	Fingerprint            string                      `protobuf:"bytes,14,opt,name=Fingerprint,proto3" json:"Fingerprint,omitempty"`                        // Hex SHA-256 of the latest version's SubjectPublicKeyInfo
+	State                  string                      `protobuf:"bytes,15,opt,name=State,proto3" json:"State,omitempty"`                                    // active, decrypt-only, disabled or destroyed
+	StateTransitions       []*EntityKeyStateTransition `protobuf:"bytes,16,rep,name=StateTransitions,proto3" json:"StateTransitions,omitempty"`              // Ordered from oldest to latest
+	NotBeforeUnixTimestamp uint64                      `protobuf:"varint,17,opt,name=NotBeforeUnixTimestamp,proto3" json:"NotBeforeUnixTimestamp,omitempty"` // Zero if active once generated
+	Status                 string                      `protobuf:"bytes,18,opt,name=Status,proto3" json:"Status,omitempty"`                                  // State computed from the not-before & expiry times: pending, active, expired or the lifecycle state
+	RotationPolicy         *EntityRotationPolicy       `protobuf:"bytes,19,opt,name=RotationPolicy,proto3" json:"RotationPolicy,omitempty"`                  // Unset if never rotated automatically
 }
 
-func (x *GenerateEntityRequest) Reset() {
-	*x = GenerateEntityRequest{}
+func (x *Entity) Reset() {
+	*x = Entity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[8]
+		mi := &file_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateEntityRequest) String() string {
+func (x *Entity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateEntityRequest) ProtoMessage() {}
+func (*Entity) ProtoMessage() {}
 
-func (x *GenerateEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[8]
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,39 +675,1067 @@ func (x *GenerateEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateEntityRequest.ProtoReflect.Descriptor instead.
-func (*GenerateEntityRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GenerateEntityRequest) GetName() string {
+func (x *Entity) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateEntityRequest) GetDescription() string {
+func (x *Entity) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *GenerateEntityRequest) GetAlgorithm() string {
+func (x *Entity) GetPublicKeyName() []byte {
 	if x != nil {
-		return x.Algorithm
+		return x.PublicKeyName
 	}
-	return ""
+	return nil
 }
 
-func (x *GenerateEntityRequest) GetExpiresInUnixTimestamp() uint64 {
+func (x *Entity) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Entity) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *Entity) GetModifiedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.ModifiedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *Entity) GetExpiresAtUnixTimestamp() uint64 {
+	if x != nil {
+		return x.ExpiresAtUnixTimestamp
+	}
+	return 0
+}
+
+func (x *Entity) GetSigningPrivateKeySeed() string {
+	if x != nil {
+		return x.SigningPrivateKeySeed
+	}
+	return ""
+}
+
+func (x *Entity) GetSigningPublicKeyPem() string {
+	if x != nil {
+		return x.SigningPublicKeyPem
+	}
+	return ""
+}
+
+func (x *Entity) GetVersions() []*EntityKeyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *Entity) GetKeySize() uint32 {
+	if x != nil {
+		return x.KeySize
+	}
+	return 0
+}
+
+func (x *Entity) GetOAEPHash() string {
+	if x != nil {
+		return x.OAEPHash
+	}
+	return ""
+}
+
+func (x *Entity) GetCipherAlgorithm() string {
+	if x != nil {
+		return x.CipherAlgorithm
+	}
+	return ""
+}
+
+func (x *Entity) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *Entity) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Entity) GetStateTransitions() []*EntityKeyStateTransition {
+	if x != nil {
+		return x.StateTransitions
+	}
+	return nil
+}
+
+func (x *Entity) GetNotBeforeUnixTimestamp() uint64 {
+	if x != nil {
+		return x.NotBeforeUnixTimestamp
+	}
+	return 0
+}
+
+func (x *Entity) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entity) GetRotationPolicy() *EntityRotationPolicy {
+	if x != nil {
+		return x.RotationPolicy
+	}
+	return nil
+}
+
+type EntityRotationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EveryUnixTimestamp uint64 `protobuf:"varint,1,opt,name=EveryUnixTimestamp,proto3" json:"EveryUnixTimestamp,omitempty"` // Rotates once the latest version is older, in milliseconds. Zero never rotating by age
+	AfterEncryptions   uint64 `protobuf:"varint,2,opt,name=AfterEncryptions,proto3" json:"AfterEncryptions,omitempty"`     // Rotates once the latest version encrypted as many files. Zero never rotating by use
+}
+
+func (x *EntityRotationPolicy) Reset() {
+	*x = EntityRotationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRotationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRotationPolicy) ProtoMessage() {}
+
+func (x *EntityRotationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRotationPolicy.ProtoReflect.Descriptor instead.
+func (*EntityRotationPolicy) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EntityRotationPolicy) GetEveryUnixTimestamp() uint64 {
+	if x != nil {
+		return x.EveryUnixTimestamp
+	}
+	return 0
+}
+
+func (x *EntityRotationPolicy) GetAfterEncryptions() uint64 {
+	if x != nil {
+		return x.AfterEncryptions
+	}
+	return 0
+}
+
+type EntityKeyStateTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State                string `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	ChangedUnixTimestamp uint64 `protobuf:"varint,2,opt,name=ChangedUnixTimestamp,proto3" json:"ChangedUnixTimestamp,omitempty"`
+}
+
+func (x *EntityKeyStateTransition) Reset() {
+	*x = EntityKeyStateTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityKeyStateTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityKeyStateTransition) ProtoMessage() {}
+
+func (x *EntityKeyStateTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityKeyStateTransition.ProtoReflect.Descriptor instead.
+func (*EntityKeyStateTransition) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EntityKeyStateTransition) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EntityKeyStateTransition) GetChangedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.ChangedUnixTimestamp
+	}
+	return 0
+}
+
+type EntityKeyVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version              uint32 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	State                string `protobuf:"bytes,2,opt,name=State,proto3" json:"State,omitempty"`
+	CreatedUnixTimestamp uint64 `protobuf:"varint,3,opt,name=CreatedUnixTimestamp,proto3" json:"CreatedUnixTimestamp,omitempty"`
+	Encryptions          uint64 `protobuf:"varint,4,opt,name=Encryptions,proto3" json:"Encryptions,omitempty"` // Files encrypted using the version
+}
+
+func (x *EntityKeyVersion) Reset() {
+	*x = EntityKeyVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityKeyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityKeyVersion) ProtoMessage() {}
+
+func (x *EntityKeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityKeyVersion.ProtoReflect.Descriptor instead.
+func (*EntityKeyVersion) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EntityKeyVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EntityKeyVersion) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EntityKeyVersion) GetCreatedUnixTimestamp() uint64 {
+	if x != nil {
+		return x.CreatedUnixTimestamp
+	}
+	return 0
+}
+
+func (x *EntityKeyVersion) GetEncryptions() uint64 {
+	if x != nil {
+		return x.Encryptions
+	}
+	return 0
+}
+
+type PublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId   string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Format  string `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`    // pem (PKIX, default), jwk or ssh
+	Version uint32 `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"` // Default: Latest version
+}
+
+func (x *PublicKeyRequest) Reset() {
+	*x = PublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKeyRequest) ProtoMessage() {}
+
+func (x *PublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*PublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublicKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *PublicKeyRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PublicKeyRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PublicKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId       string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Version     uint32 `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Algorithm   string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	Format      string `protobuf:"bytes,4,opt,name=Format,proto3" json:"Format,omitempty"`
+	PublicKey   []byte `protobuf:"bytes,5,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Fingerprint string `protobuf:"bytes,6,opt,name=Fingerprint,proto3" json:"Fingerprint,omitempty"` // Hex SHA-256 of the SubjectPublicKeyInfo
+}
+
+func (x *PublicKey) Reset() {
+	*x = PublicKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKey) ProtoMessage() {}
+
+func (x *PublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKey.ProtoReflect.Descriptor instead.
+func (*PublicKey) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PublicKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *PublicKey) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PublicKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *PublicKey) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PublicKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PublicKey) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+type EntityModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   string                `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description            string                `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	KeyId                  string                `protobuf:"bytes,3,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	ModifyKeyExpiration    bool                  `protobuf:"varint,4,opt,name=ModifyKeyExpiration,proto3" json:"ModifyKeyExpiration,omitempty"`
+	ExpiresInUnixTimestamp uint64                `protobuf:"varint,5,opt,name=ExpiresInUnixTimestamp,proto3" json:"ExpiresInUnixTimestamp,omitempty"`
+	ModifyKeyNotBefore     bool                  `protobuf:"varint,6,opt,name=ModifyKeyNotBefore,proto3" json:"ModifyKeyNotBefore,omitempty"`
+	NotBeforeUnixTimestamp uint64                `protobuf:"varint,7,opt,name=NotBeforeUnixTimestamp,proto3" json:"NotBeforeUnixTimestamp,omitempty"` // Zero activates the key
+	ModifyRotationPolicy   bool                  `protobuf:"varint,8,opt,name=ModifyRotationPolicy,proto3" json:"ModifyRotationPolicy,omitempty"`
+	RotationPolicy         *EntityRotationPolicy `protobuf:"bytes,9,opt,name=RotationPolicy,proto3" json:"RotationPolicy,omitempty"` // Replaces the key's rotation policy, unset never rotating automatically
+}
+
+func (x *EntityModifyRequest) Reset() {
+	*x = EntityModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityModifyRequest) ProtoMessage() {}
+
+func (x *EntityModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityModifyRequest.ProtoReflect.Descriptor instead.
+func (*EntityModifyRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EntityModifyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntityModifyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EntityModifyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EntityModifyRequest) GetModifyKeyExpiration() bool {
+	if x != nil {
+		return x.ModifyKeyExpiration
+	}
+	return false
+}
+
+func (x *EntityModifyRequest) GetExpiresInUnixTimestamp() uint64 {
+	if x != nil {
+		return x.ExpiresInUnixTimestamp
+	}
+	return 0
+}
+
+func (x *EntityModifyRequest) GetModifyKeyNotBefore() bool {
+	if x != nil {
+		return x.ModifyKeyNotBefore
+	}
+	return false
+}
+
+func (x *EntityModifyRequest) GetNotBeforeUnixTimestamp() uint64 {
+	if x != nil {
+		return x.NotBeforeUnixTimestamp
+	}
+	return 0
+}
+
+func (x *EntityModifyRequest) GetModifyRotationPolicy() bool {
+	if x != nil {
+		return x.ModifyRotationPolicy
+	}
+	return false
+}
+
+func (x *EntityModifyRequest) GetRotationPolicy() *EntityRotationPolicy {
+	if x != nil {
+		return x.RotationPolicy
+	}
+	return nil
+}
+
+type EntityRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId  string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Mode   string `protobuf:"bytes,2,opt,name=Mode,proto3" json:"Mode,omitempty"`      // Handling of dependent files: "" refuses, "cascade" or "orphan"
+	DryRun bool   `protobuf:"varint,3,opt,name=DryRun,proto3" json:"DryRun,omitempty"` // Only previews the removal
+}
+
+func (x *EntityRemoveRequest) Reset() {
+	*x = EntityRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRemoveRequest) ProtoMessage() {}
+
+func (x *EntityRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRemoveRequest.ProtoReflect.Descriptor instead.
+func (*EntityRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EntityRemoveRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EntityRemoveRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *EntityRemoveRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type EntityRemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity         *Entity  `protobuf:"bytes,1,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	Removed        bool     `protobuf:"varint,2,opt,name=Removed,proto3" json:"Removed,omitempty"`
+	DependentPaths []string `protobuf:"bytes,3,rep,name=DependentPaths,proto3" json:"DependentPaths,omitempty"` // Stored files encrypted with the key
+	RemovedPaths   []string `protobuf:"bytes,4,rep,name=RemovedPaths,proto3" json:"RemovedPaths,omitempty"`     // Dependent files removed along with the key
+}
+
+func (x *EntityRemoveResponse) Reset() {
+	*x = EntityRemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRemoveResponse) ProtoMessage() {}
+
+func (x *EntityRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRemoveResponse.ProtoReflect.Descriptor instead.
+func (*EntityRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EntityRemoveResponse) GetEntity() *Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *EntityRemoveResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *EntityRemoveResponse) GetDependentPaths() []string {
+	if x != nil {
+		return x.DependentPaths
+	}
+	return nil
+}
+
+func (x *EntityRemoveResponse) GetRemovedPaths() []string {
+	if x != nil {
+		return x.RemovedPaths
+	}
+	return nil
+}
+
+type KeyVersionModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId   string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Version uint32 `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	State   string `protobuf:"bytes,3,opt,name=State,proto3" json:"State,omitempty"` // enabled, disabled or destroyed
+}
+
+func (x *KeyVersionModifyRequest) Reset() {
+	*x = KeyVersionModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyVersionModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyVersionModifyRequest) ProtoMessage() {}
+
+func (x *KeyVersionModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyVersionModifyRequest.ProtoReflect.Descriptor instead.
+func (*KeyVersionModifyRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *KeyVersionModifyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyVersionModifyRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *KeyVersionModifyRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type KeyStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId       string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	DecryptOnly bool   `protobuf:"varint,2,opt,name=DecryptOnly,proto3" json:"DecryptOnly,omitempty"` // Enables the key for decryption only, refusing new encryptions
+}
+
+func (x *KeyStateRequest) Reset() {
+	*x = KeyStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyStateRequest) ProtoMessage() {}
+
+func (x *KeyStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyStateRequest.ProtoReflect.Descriptor instead.
+func (*KeyStateRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *KeyStateRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyStateRequest) GetDecryptOnly() bool {
+	if x != nil {
+		return x.DecryptOnly
+	}
+	return false
+}
+
+type KeyRotationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+}
+
+func (x *KeyRotationRequest) Reset() {
+	*x = KeyRotationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRotationRequest) ProtoMessage() {}
+
+func (x *KeyRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRotationRequest.ProtoReflect.Descriptor instead.
+func (*KeyRotationRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KeyRotationRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type KeyRotationProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilePath  string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`    // Stored file processed
+	Processed uint64 `protobuf:"varint,2,opt,name=Processed,proto3" json:"Processed,omitempty"` // Files processed so far
+	Total     uint64 `protobuf:"varint,3,opt,name=Total,proto3" json:"Total,omitempty"`         // Total stored files
+	Error     string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`          // Empty if file was rotated successfully
+}
+
+func (x *KeyRotationProgress) Reset() {
+	*x = KeyRotationProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRotationProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRotationProgress) ProtoMessage() {}
+
+func (x *KeyRotationProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRotationProgress.ProtoReflect.Descriptor instead.
+func (*KeyRotationProgress) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *KeyRotationProgress) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *KeyRotationProgress) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *KeyRotationProgress) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *KeyRotationProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GenerateEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   string                `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description            string                `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Algorithm              string                `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	ExpiresInUnixTimestamp uint64                `protobuf:"varint,4,opt,name=ExpiresInUnixTimestamp,proto3" json:"ExpiresInUnixTimestamp,omitempty"`
+	KeySize                uint32                `protobuf:"varint,5,opt,name=KeySize,proto3" json:"KeySize,omitempty"`                               // RSA key size in bits, defaults to the server's configuration
+	OAEPHash               string                `protobuf:"bytes,6,opt,name=OAEPHash,proto3" json:"OAEPHash,omitempty"`                              // RSA-OAEP hash, defaults to the server's configuration
+	CipherAlgorithm        string                `protobuf:"bytes,7,opt,name=CipherAlgorithm,proto3" json:"CipherAlgorithm,omitempty"`                // File data cipher, defaults to the server's configuration
+	NotBeforeUnixTimestamp uint64                `protobuf:"varint,8,opt,name=NotBeforeUnixTimestamp,proto3" json:"NotBeforeUnixTimestamp,omitempty"` // Activation time, zero activating the key once generated
+	RotationPolicy         *EntityRotationPolicy `protobuf:"bytes,9,opt,name=RotationPolicy,proto3" json:"RotationPolicy,omitempty"`                  // Unset never rotating automatically
+}
+
+func (x *GenerateEntityRequest) Reset() {
+	*x = GenerateEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEntityRequest) ProtoMessage() {}
+
+func (x *GenerateEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEntityRequest.ProtoReflect.Descriptor instead.
+func (*GenerateEntityRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GenerateEntityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenerateEntityRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GenerateEntityRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GenerateEntityRequest) GetExpiresInUnixTimestamp() uint64 {
 	if x != nil {
 		return x.ExpiresInUnixTimestamp
 	}
 	return 0
 }
 
+func (x *GenerateEntityRequest) GetKeySize() uint32 {
+	if x != nil {
+		return x.KeySize
+	}
+	return 0
+}
+
+func (x *GenerateEntityRequest) GetOAEPHash() string {
+	if x != nil {
+		return x.OAEPHash
+	}
+	return ""
+}
+
+func (x *GenerateEntityRequest) GetCipherAlgorithm() string {
+	if x != nil {
+		return x.CipherAlgorithm
+	}
+	return ""
+}
+
+func (x *GenerateEntityRequest) GetNotBeforeUnixTimestamp() uint64 {
+	if x != nil {
+		return x.NotBeforeUnixTimestamp
+	}
+	return 0
+}
+
+func (x *GenerateEntityRequest) GetRotationPolicy() *EntityRotationPolicy {
+	if x != nil {
+		return x.RotationPolicy
+	}
+	return nil
+}
+
 // KEYS
 type GetKeysResponse struct {
 	state         protoimpl.MessageState
@@ -658,7 +1748,7 @@ type GetKeysResponse struct {
 func (x *GetKeysResponse) Reset() {
 	*x = GetKeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[9]
+		mi := &file_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -671,7 +1761,7 @@ func (x *GetKeysResponse) String() string {
 func (*GetKeysResponse) ProtoMessage() {}
 
 func (x *GetKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[9]
+	mi := &file_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +1774,7 @@ func (x *GetKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeysResponse.ProtoReflect.Descriptor instead.
 func (*GetKeysResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{9}
+	return file_server_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetKeysResponse) GetEntities() []*Entity {
@@ -705,7 +1795,7 @@ type GetKeyNamesResponse struct {
 func (x *GetKeyNamesResponse) Reset() {
 	*x = GetKeyNamesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[10]
+		mi := &file_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -718,7 +1808,7 @@ func (x *GetKeyNamesResponse) String() string {
 func (*GetKeyNamesResponse) ProtoMessage() {}
 
 func (x *GetKeyNamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[10]
+	mi := &file_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1821,7 @@ func (x *GetKeyNamesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKeyNamesResponse.ProtoReflect.Descriptor instead.
 func (*GetKeyNamesResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{10}
+	return file_server_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetKeyNamesResponse) GetKeys() []string {
@@ -741,21 +1831,197 @@ func (x *GetKeyNamesResponse) GetKeys() []string {
 	return nil
 }
 
+type KeyAlgorithm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Signing     bool   `protobuf:"varint,3,opt,name=Signing,proto3" json:"Signing,omitempty"` // Requests are required to be signed by the client
+}
+
+func (x *KeyAlgorithm) Reset() {
+	*x = KeyAlgorithm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyAlgorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyAlgorithm) ProtoMessage() {}
+
+func (x *KeyAlgorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyAlgorithm.ProtoReflect.Descriptor instead.
+func (*KeyAlgorithm) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KeyAlgorithm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeyAlgorithm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KeyAlgorithm) GetSigning() bool {
+	if x != nil {
+		return x.Signing
+	}
+	return false
+}
+
+type CipherAlgorithm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+}
+
+func (x *CipherAlgorithm) Reset() {
+	*x = CipherAlgorithm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CipherAlgorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CipherAlgorithm) ProtoMessage() {}
+
+func (x *CipherAlgorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CipherAlgorithm.ProtoReflect.Descriptor instead.
+func (*CipherAlgorithm) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CipherAlgorithm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CipherAlgorithm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type KeyAlgorithmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithms []*KeyAlgorithm    `protobuf:"bytes,1,rep,name=Algorithms,proto3" json:"Algorithms,omitempty"`
+	Ciphers    []*CipherAlgorithm `protobuf:"bytes,2,rep,name=Ciphers,proto3" json:"Ciphers,omitempty"` // Ciphers selectable when generating keys
+}
+
+func (x *KeyAlgorithmsResponse) Reset() {
+	*x = KeyAlgorithmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyAlgorithmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyAlgorithmsResponse) ProtoMessage() {}
+
+func (x *KeyAlgorithmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyAlgorithmsResponse.ProtoReflect.Descriptor instead.
+func (*KeyAlgorithmsResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *KeyAlgorithmsResponse) GetAlgorithms() []*KeyAlgorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *KeyAlgorithmsResponse) GetCiphers() []*CipherAlgorithm {
+	if x != nil {
+		return x.Ciphers
+	}
+	return nil
+}
+
 // KEYS: IMPORT
 type KeyImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyGzip []byte `protobuf:"bytes,1,opt,name=KeyGzip,proto3" json:"KeyGzip,omitempty"`
-	KeyId   string `protobuf:"bytes,2,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
-	Force   bool   `protobuf:"varint,3,opt,name=Force,proto3" json:"Force,omitempty"`
+	KeyGzip    []byte `protobuf:"bytes,1,opt,name=KeyGzip,proto3" json:"KeyGzip,omitempty"`
+	KeyId      string `protobuf:"bytes,2,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Force      bool   `protobuf:"varint,3,opt,name=Force,proto3" json:"Force,omitempty"`
+	Passphrase string `protobuf:"bytes,4,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"` // Passphrase the package was exported with, or protecting an OpenSSH key
+	Format     string `protobuf:"bytes,5,opt,name=Format,proto3" json:"Format,omitempty"`         // Format of the imported key: package (default), pem, openssh or jwk
+	Mode       string `protobuf:"bytes,6,opt,name=Mode,proto3" json:"Mode,omitempty"`             // Handling of the overwritten key's dependent files: "" refuses, "cascade" or "orphan"
 }
 
 func (x *KeyImportRequest) Reset() {
 	*x = KeyImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[11]
+		mi := &file_server_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -768,7 +2034,7 @@ func (x *KeyImportRequest) String() string {
 func (*KeyImportRequest) ProtoMessage() {}
 
 func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[11]
+	mi := &file_server_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +2047,7 @@ func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyImportRequest.ProtoReflect.Descriptor instead.
 func (*KeyImportRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{11}
+	return file_server_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *KeyImportRequest) GetKeyGzip() []byte {
@@ -805,6 +2071,27 @@ func (x *KeyImportRequest) GetForce() bool {
 	return false
 }
 
+func (x *KeyImportRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *KeyImportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *KeyImportRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
 type KeyImportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -814,7 +2101,7 @@ type KeyImportResponse struct {
 func (x *KeyImportResponse) Reset() {
 	*x = KeyImportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[12]
+		mi := &file_server_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -827,7 +2114,7 @@ func (x *KeyImportResponse) String() string {
 func (*KeyImportResponse) ProtoMessage() {}
 
 func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[12]
+	mi := &file_server_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +2127,7 @@ func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyImportResponse.ProtoReflect.Descriptor instead.
 func (*KeyImportResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{12}
+	return file_server_proto_rawDescGZIP(), []int{29}
 }
 
 // KEYS: EXPORT
@@ -849,13 +2136,16 @@ type KeyExportRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyId string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	KeyId              string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Passphrase         string `protobuf:"bytes,2,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`                 // Passphrase the package is encrypted with
+	InsecurePlaintext  bool   `protobuf:"varint,3,opt,name=InsecurePlaintext,proto3" json:"InsecurePlaintext,omitempty"`  // Exports the package unencrypted, without a passphrase
+	TransportPublicKey []byte `protobuf:"bytes,4,opt,name=TransportPublicKey,proto3" json:"TransportPublicKey,omitempty"` // Destination server's transport public key the package is sealed for
 }
 
 func (x *KeyExportRequest) Reset() {
 	*x = KeyExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[13]
+		mi := &file_server_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +2158,7 @@ func (x *KeyExportRequest) String() string {
 func (*KeyExportRequest) ProtoMessage() {}
 
 func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[13]
+	mi := &file_server_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,12 +2171,88 @@ func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyExportRequest.ProtoReflect.Descriptor instead.
 func (*KeyExportRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{13}
+	return file_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KeyExportRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *KeyExportRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *KeyExportRequest) GetInsecurePlaintext() bool {
+	if x != nil {
+		return x.InsecurePlaintext
+	}
+	return false
+}
+
+func (x *KeyExportRequest) GetTransportPublicKey() []byte {
+	if x != nil {
+		return x.TransportPublicKey
+	}
+	return nil
+}
+
+type TransportKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"` // PKIX PEM encoded public key
+	Algorithm string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+}
+
+func (x *TransportKey) Reset() {
+	*x = TransportKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransportKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransportKey) ProtoMessage() {}
+
+func (x *TransportKey) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransportKey.ProtoReflect.Descriptor instead.
+func (*TransportKey) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransportKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
 }
 
-func (x *KeyExportRequest) GetKeyId() string {
+func (x *TransportKey) GetAlgorithm() string {
 	if x != nil {
-		return x.KeyId
+		return x.Algorithm
 	}
 	return ""
 }
@@ -903,7 +2269,7 @@ type KeyExportResponse struct {
 func (x *KeyExportResponse) Reset() {
 	*x = KeyExportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[14]
+		mi := &file_server_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +2282,7 @@ func (x *KeyExportResponse) String() string {
 func (*KeyExportResponse) ProtoMessage() {}
 
 func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[14]
+	mi := &file_server_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +2295,7 @@ func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyExportResponse.ProtoReflect.Descriptor instead.
 func (*KeyExportResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{14}
+	return file_server_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *KeyExportResponse) GetKeyGzip() []byte {
@@ -959,7 +2325,7 @@ type ListPathContentRequest struct {
 func (x *ListPathContentRequest) Reset() {
 	*x = ListPathContentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[15]
+		mi := &file_server_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -972,7 +2338,7 @@ func (x *ListPathContentRequest) String() string {
 func (*ListPathContentRequest) ProtoMessage() {}
 
 func (x *ListPathContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[15]
+	mi := &file_server_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +2351,7 @@ func (x *ListPathContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPathContentRequest.ProtoReflect.Descriptor instead.
 func (*ListPathContentRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{15}
+	return file_server_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListPathContentRequest) GetPath() string {
@@ -1012,12 +2378,14 @@ type ContentType struct {
 	SizeInBytes           uint64 `protobuf:"varint,3,opt,name=SizeInBytes,proto3" json:"SizeInBytes,omitempty"`
 	CreatedUnixTimestamp  uint64 `protobuf:"varint,4,opt,name=CreatedUnixTimestamp,proto3" json:"CreatedUnixTimestamp,omitempty"`
 	ModifiedUnixTimestamp uint64 `protobuf:"varint,5,opt,name=ModifiedUnixTimestamp,proto3" json:"ModifiedUnixTimestamp,omitempty"`
+	KeyName               string `protobuf:"bytes,6,opt,name=KeyName,proto3" json:"KeyName,omitempty"`        // Key that encrypted the file, empty if unknown
+	KeyVersion            uint32 `protobuf:"varint,7,opt,name=KeyVersion,proto3" json:"KeyVersion,omitempty"` // Version of the key that encrypted the file
 }
 
 func (x *ContentType) Reset() {
 	*x = ContentType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[16]
+		mi := &file_server_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1030,7 +2398,7 @@ func (x *ContentType) String() string {
 func (*ContentType) ProtoMessage() {}
 
 func (x *ContentType) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[16]
+	mi := &file_server_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,7 +2411,7 @@ func (x *ContentType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContentType.ProtoReflect.Descriptor instead.
 func (*ContentType) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{16}
+	return file_server_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ContentType) GetName() string {
@@ -1081,6 +2449,20 @@ func (x *ContentType) GetModifiedUnixTimestamp() uint64 {
 	return 0
 }
 
+func (x *ContentType) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *ContentType) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
 type PathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1092,7 +2474,7 @@ type PathResponse struct {
 func (x *PathResponse) Reset() {
 	*x = PathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[17]
+		mi := &file_server_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1105,7 +2487,7 @@ func (x *PathResponse) String() string {
 func (*PathResponse) ProtoMessage() {}
 
 func (x *PathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[17]
+	mi := &file_server_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +2500,7 @@ func (x *PathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PathResponse.ProtoReflect.Descriptor instead.
 func (*PathResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{17}
+	return file_server_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PathResponse) GetContent() []*ContentType {
@@ -1142,7 +2524,7 @@ type BackupEntry struct {
 func (x *BackupEntry) Reset() {
 	*x = BackupEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[18]
+		mi := &file_server_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +2537,7 @@ func (x *BackupEntry) String() string {
 func (*BackupEntry) ProtoMessage() {}
 
 func (x *BackupEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[18]
+	mi := &file_server_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +2550,7 @@ func (x *BackupEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupEntry.ProtoReflect.Descriptor instead.
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{18}
+	return file_server_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *BackupEntry) GetFileName() string {
@@ -1203,7 +2585,7 @@ type BackupEntries struct {
 func (x *BackupEntries) Reset() {
 	*x = BackupEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[19]
+		mi := &file_server_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1216,7 +2598,7 @@ func (x *BackupEntries) String() string {
 func (*BackupEntries) ProtoMessage() {}
 
 func (x *BackupEntries) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[19]
+	mi := &file_server_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +2611,7 @@ func (x *BackupEntries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupEntries.ProtoReflect.Descriptor instead.
 func (*BackupEntries) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{19}
+	return file_server_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *BackupEntries) GetBackups() []*BackupEntry {
@@ -1254,7 +2636,7 @@ type BackupManagerStatus struct {
 func (x *BackupManagerStatus) Reset() {
 	*x = BackupManagerStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[20]
+		mi := &file_server_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1267,7 +2649,7 @@ func (x *BackupManagerStatus) String() string {
 func (*BackupManagerStatus) ProtoMessage() {}
 
 func (x *BackupManagerStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[20]
+	mi := &file_server_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,7 +2662,7 @@ func (x *BackupManagerStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupManagerStatus.ProtoReflect.Descriptor instead.
 func (*BackupManagerStatus) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{20}
+	return file_server_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *BackupManagerStatus) GetIsEnabled() bool {
@@ -1329,7 +2711,7 @@ type BackupEntryRequest struct {
 func (x *BackupEntryRequest) Reset() {
 	*x = BackupEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[21]
+		mi := &file_server_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1342,7 +2724,7 @@ func (x *BackupEntryRequest) String() string {
 func (*BackupEntryRequest) ProtoMessage() {}
 
 func (x *BackupEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[21]
+	mi := &file_server_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +2737,7 @@ func (x *BackupEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupEntryRequest.ProtoReflect.Descriptor instead.
 func (*BackupEntryRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{21}
+	return file_server_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *BackupEntryRequest) GetBackupFileName() string {
@@ -1378,7 +2760,7 @@ type ExportedBackupResponse struct {
 func (x *ExportedBackupResponse) Reset() {
 	*x = ExportedBackupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[22]
+		mi := &file_server_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1391,7 +2773,7 @@ func (x *ExportedBackupResponse) String() string {
 func (*ExportedBackupResponse) ProtoMessage() {}
 
 func (x *ExportedBackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[22]
+	mi := &file_server_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +2786,7 @@ func (x *ExportedBackupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportedBackupResponse.ProtoReflect.Descriptor instead.
 func (*ExportedBackupResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{22}
+	return file_server_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ExportedBackupResponse) GetFileName() string {
@@ -1440,7 +2822,7 @@ type ImportBackupRequest struct {
 func (x *ImportBackupRequest) Reset() {
 	*x = ImportBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[23]
+		mi := &file_server_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +2835,7 @@ func (x *ImportBackupRequest) String() string {
 func (*ImportBackupRequest) ProtoMessage() {}
 
 func (x *ImportBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[23]
+	mi := &file_server_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +2848,7 @@ func (x *ImportBackupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportBackupRequest.ProtoReflect.Descriptor instead.
 func (*ImportBackupRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{23}
+	return file_server_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ImportBackupRequest) GetFileName() string {
@@ -1494,7 +2876,7 @@ type RestoreFromBackupRequest struct {
 func (x *RestoreFromBackupRequest) Reset() {
 	*x = RestoreFromBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[24]
+		mi := &file_server_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1507,7 +2889,7 @@ func (x *RestoreFromBackupRequest) String() string {
 func (*RestoreFromBackupRequest) ProtoMessage() {}
 
 func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[24]
+	mi := &file_server_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +2902,7 @@ func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreFromBackupRequest.ProtoReflect.Descriptor instead.
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{24}
+	return file_server_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RestoreFromBackupRequest) GetFileName() string {
@@ -1530,6 +2912,251 @@ func (x *RestoreFromBackupRequest) GetFileName() string {
 	return ""
 }
 
+// OPERATOR
+type InitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares    uint32 `protobuf:"varint,1,opt,name=Shares,proto3" json:"Shares,omitempty"`
+	Threshold uint32 `protobuf:"varint,2,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+}
+
+func (x *InitRequest) Reset() {
+	*x = InitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitRequest) ProtoMessage() {}
+
+func (x *InitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InitRequest) GetShares() uint32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *InitRequest) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type InitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares    []string `protobuf:"bytes,1,rep,name=Shares,proto3" json:"Shares,omitempty"` // Base64 encoded unseal shares
+	Threshold uint32   `protobuf:"varint,2,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+}
+
+func (x *InitResponse) Reset() {
+	*x = InitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitResponse) ProtoMessage() {}
+
+func (x *InitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
+func (*InitResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InitResponse) GetShares() []string {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *InitResponse) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type UnsealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share         string `protobuf:"bytes,1,opt,name=Share,proto3" json:"Share,omitempty"`                  // Base64 encoded unseal share
+	ResetProgress bool   `protobuf:"varint,2,opt,name=ResetProgress,proto3" json:"ResetProgress,omitempty"` // Discards previously submitted shares
+}
+
+func (x *UnsealRequest) Reset() {
+	*x = UnsealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsealRequest) ProtoMessage() {}
+
+func (x *UnsealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsealRequest.ProtoReflect.Descriptor instead.
+func (*UnsealRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnsealRequest) GetShare() string {
+	if x != nil {
+		return x.Share
+	}
+	return ""
+}
+
+func (x *UnsealRequest) GetResetProgress() bool {
+	if x != nil {
+		return x.ResetProgress
+	}
+	return false
+}
+
+type SealStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sealed      bool   `protobuf:"varint,1,opt,name=Sealed,proto3" json:"Sealed,omitempty"`
+	Initialized bool   `protobuf:"varint,2,opt,name=Initialized,proto3" json:"Initialized,omitempty"` // Whether the master key was split into unseal shares
+	Shares      uint32 `protobuf:"varint,3,opt,name=Shares,proto3" json:"Shares,omitempty"`
+	Threshold   uint32 `protobuf:"varint,4,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	Progress    uint32 `protobuf:"varint,5,opt,name=Progress,proto3" json:"Progress,omitempty"` // Number of shares submitted towards unsealing
+}
+
+func (x *SealStatus) Reset() {
+	*x = SealStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealStatus) ProtoMessage() {}
+
+func (x *SealStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealStatus.ProtoReflect.Descriptor instead.
+func (*SealStatus) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SealStatus) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+func (x *SealStatus) GetInitialized() bool {
+	if x != nil {
+		return x.Initialized
+	}
+	return false
+}
+
+func (x *SealStatus) GetShares() uint32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *SealStatus) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SealStatus) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
 // MISC
 type EmptyMessage struct {
 	state         protoimpl.MessageState
@@ -1540,7 +3167,7 @@ type EmptyMessage struct {
 func (x *EmptyMessage) Reset() {
 	*x = EmptyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[25]
+		mi := &file_server_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1553,7 +3180,7 @@ func (x *EmptyMessage) String() string {
 func (*EmptyMessage) ProtoMessage() {}
 
 func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[25]
+	mi := &file_server_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,7 +3193,7 @@ func (x *EmptyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyMessage.ProtoReflect.Descriptor instead.
 func (*EmptyMessage) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{25}
+	return file_server_proto_rawDescGZIP(), []int{47}
 }
 
 // MISC: Server Version
@@ -1579,7 +3206,7 @@ type ServerVersionRequest struct {
 func (x *ServerVersionRequest) Reset() {
 	*x = ServerVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[26]
+		mi := &file_server_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1592,7 +3219,7 @@ func (x *ServerVersionRequest) String() string {
 func (*ServerVersionRequest) ProtoMessage() {}
 
 func (x *ServerVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[26]
+	mi := &file_server_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,7 +3232,7 @@ func (x *ServerVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerVersionRequest.ProtoReflect.Descriptor instead.
 func (*ServerVersionRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{26}
+	return file_server_proto_rawDescGZIP(), []int{48}
 }
 
 type ServerVersionResponse struct {
@@ -1619,7 +3246,7 @@ type ServerVersionResponse struct {
 func (x *ServerVersionResponse) Reset() {
 	*x = ServerVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[27]
+		mi := &file_server_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1632,7 +3259,7 @@ func (x *ServerVersionResponse) String() string {
 func (*ServerVersionResponse) ProtoMessage() {}
 
 func (x *ServerVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[27]
+	mi := &file_server_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +3272,7 @@ func (x *ServerVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerVersionResponse.ProtoReflect.Descriptor instead.
 func (*ServerVersionResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_rawDescGZIP(), []int{27}
+	return file_server_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ServerVersionResponse) GetVersion() string {
@@ -1671,186 +3298,420 @@ var file_server_proto_rawDesc = []byte{
 	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x12, 0x24, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
 	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x67, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x74, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x06, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x32,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x6e,
-	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x34, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
-	0x65, 0x79, 0x53, 0x65, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x79, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
-	0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65,
-	0x79, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4f, 0x76, 0x65, 0x72, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x22, 0x74, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69,
+	0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x0d, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3f, 0x0a,
+	0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x6c,
+	0x0a, 0x16, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x61, 0x74, 0x68, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x50,
+	0x61, 0x74, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x17,
+	0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0xbe, 0x06, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36,
+	0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x50, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x34,
+	0x0a, 0x08, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x4f, 0x41, 0x45, 0x50, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4f, 0x41, 0x45, 0x50, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x69,
+	0x70, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x10,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x4e, 0x6f,
+	0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x4e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x72, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x72,
+	0x79, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x45, 0x76, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x41, 0x66, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x18, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x55, 0x6e, 0x69,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x22, 0xad, 0x03, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
 	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e,
-	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4b,
-	0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x4b,
-	0x65, 0x79, 0x73, 0x22, 0x58, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x47, 0x7a,
-	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x47, 0x7a, 0x69,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x11,
-	0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x47, 0x7a, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x47, 0x7a, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x4b,
-	0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49,
-	0x64, 0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0xc1, 0x01,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x53, 0x69, 0x7a, 0x65,
-	0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e,
-	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x3d, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x95, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4b, 0x65,
+	0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79,
+	0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x14, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x44, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x57, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa4,
+	0x01, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x5f, 0x0a, 0x17, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x0f, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x2a, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x7b, 0x0a,
+	0x13, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x81, 0x03, 0x0a, 0x15, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x41,
+	0x45, 0x50, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x41,
+	0x45, 0x50, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x36, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x16, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3d,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x5e, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x47, 0x0a, 0x0f, 0x43, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x70, 0x68,
+	0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x07, 0x43, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79,
+	0x47, 0x7a, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x47,
+	0x7a, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4b,
+	0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa6, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x49,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x50, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x4a, 0x0a, 0x0c, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x43, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65,
+	0x79, 0x47, 0x7a, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x4b, 0x65, 0x79,
+	0x47, 0x7a, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69,
-	0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e, 0x69, 0x78, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3e, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x38,
-	0x0a, 0x17, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x55, 0x6e, 0x69, 0x78,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x17, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x55, 0x6e, 0x69, 0x78, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f,
-	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
-	0x22, 0x3c, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x84,
-	0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x46, 0x69, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x55, 0x6e, 0x69,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x55, 0x6e,
+	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3e, 0x0a, 0x0d, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x13,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x38, 0x0a, 0x17, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x55,
+	0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x55, 0x6e,
+	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x3c, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
 	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46,
-	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0e, 0x0a, 0x0c,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x80, 0x0b, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e,
-	0x41, 0x62, 0x79, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4d, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x43, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0x44, 0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x4b, 0x0a, 0x0d, 0x55, 0x6e,
+	0x73, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xe1, 0x12,
+	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x62, 0x79, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b,
+	0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
 	0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
@@ -1858,80 +3719,133 @@ var file_server_proto_rawDesc = []byte{
 	0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
 	0x6f, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x45, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4b, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b,
+	0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b,
+	0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x17,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x15, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12,
-	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x11, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x1a, 0x14, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x15,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
-	0x65, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x42, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x1a, 0x14, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x16, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x1b,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a,
-	0x16, 0x53, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x20,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46,
-	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x73, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x04, 0x53, 0x65, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1946,87 +3860,149 @@ func file_server_proto_rawDescGZIP() []byte {
 	return file_server_proto_rawDescData
 }
 
-var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_server_proto_goTypes = []interface{}{
 	(*FilePacket)(nil),               // 0: server.FilePacket
 	(*FileOptions)(nil),              // 1: server.FileOptions
 	(*DecryptRequest)(nil),           // 2: server.DecryptRequest
-	(*EncryptResult)(nil),            // 3: server.EncryptResult
-	(*EntityMod)(nil),                // 4: server.EntityMod
-	(*Entity)(nil),                   // 5: server.Entity
-	(*EntityModifyRequest)(nil),      // 6: server.EntityModifyRequest
-	(*EntityRemoveRequest)(nil),      // 7: server.EntityRemoveRequest
-	(*GenerateEntityRequest)(nil),    // 8: server.GenerateEntityRequest
-	(*GetKeysResponse)(nil),          // 9: server.GetKeysResponse
-	(*GetKeyNamesResponse)(nil),      // 10: server.GetKeyNamesResponse
-	(*KeyImportRequest)(nil),         // 11: server.KeyImportRequest
-	(*KeyImportResponse)(nil),        // 12: server.KeyImportResponse
-	(*KeyExportRequest)(nil),         // 13: server.KeyExportRequest
-	(*KeyExportResponse)(nil),        // 14: server.KeyExportResponse
-	(*ListPathContentRequest)(nil),   // 15: server.ListPathContentRequest
-	(*ContentType)(nil),              // 16: server.ContentType
-	(*PathResponse)(nil),             // 17: server.PathResponse
-	(*BackupEntry)(nil),              // 18: server.BackupEntry
-	(*BackupEntries)(nil),            // 19: server.BackupEntries
-	(*BackupManagerStatus)(nil),      // 20: server.BackupManagerStatus
-	(*BackupEntryRequest)(nil),       // 21: server.BackupEntryRequest
-	(*ExportedBackupResponse)(nil),   // 22: server.ExportedBackupResponse
-	(*ImportBackupRequest)(nil),      // 23: server.ImportBackupRequest
-	(*RestoreFromBackupRequest)(nil), // 24: server.RestoreFromBackupRequest
-	(*EmptyMessage)(nil),             // 25: server.EmptyMessage
-	(*ServerVersionRequest)(nil),     // 26: server.ServerVersionRequest
-	(*ServerVersionResponse)(nil),    // 27: server.ServerVersionResponse
+	(*FileStreamHeader)(nil),         // 3: server.FileStreamHeader
+	(*FileStreamPacket)(nil),         // 4: server.FileStreamPacket
+	(*EncryptResult)(nil),            // 5: server.EncryptResult
+	(*EntityMod)(nil),                // 6: server.EntityMod
+	(*CipherMigrationRequest)(nil),   // 7: server.CipherMigrationRequest
+	(*CipherMigrationResponse)(nil),  // 8: server.CipherMigrationResponse
+	(*Entity)(nil),                   // 9: server.Entity
+	(*EntityRotationPolicy)(nil),     // 10: server.EntityRotationPolicy
+	(*EntityKeyStateTransition)(nil), // 11: server.EntityKeyStateTransition
+	(*EntityKeyVersion)(nil),         // 12: server.EntityKeyVersion
+	(*PublicKeyRequest)(nil),         // 13: server.PublicKeyRequest
+	(*PublicKey)(nil),                // 14: server.PublicKey
+	(*EntityModifyRequest)(nil),      // 15: server.EntityModifyRequest
+	(*EntityRemoveRequest)(nil),      // 16: server.EntityRemoveRequest
+	(*EntityRemoveResponse)(nil),     // 17: server.EntityRemoveResponse
+	(*KeyVersionModifyRequest)(nil),  // 18: server.KeyVersionModifyRequest
+	(*KeyStateRequest)(nil),          // 19: server.KeyStateRequest
+	(*KeyRotationRequest)(nil),       // 20: server.KeyRotationRequest
+	(*KeyRotationProgress)(nil),      // 21: server.KeyRotationProgress
+	(*GenerateEntityRequest)(nil),    // 22: server.GenerateEntityRequest
+	(*GetKeysResponse)(nil),          // 23: server.GetKeysResponse
+	(*GetKeyNamesResponse)(nil),      // 24: server.GetKeyNamesResponse
+	(*KeyAlgorithm)(nil),             // 25: server.KeyAlgorithm
+	(*CipherAlgorithm)(nil),          // 26: server.CipherAlgorithm
+	(*KeyAlgorithmsResponse)(nil),    // 27: server.KeyAlgorithmsResponse
+	(*KeyImportRequest)(nil),         // 28: server.KeyImportRequest
+	(*KeyImportResponse)(nil),        // 29: server.KeyImportResponse
+	(*KeyExportRequest)(nil),         // 30: server.KeyExportRequest
+	(*TransportKey)(nil),             // 31: server.TransportKey
+	(*KeyExportResponse)(nil),        // 32: server.KeyExportResponse
+	(*ListPathContentRequest)(nil),   // 33: server.ListPathContentRequest
+	(*ContentType)(nil),              // 34: server.ContentType
+	(*PathResponse)(nil),             // 35: server.PathResponse
+	(*BackupEntry)(nil),              // 36: server.BackupEntry
+	(*BackupEntries)(nil),            // 37: server.BackupEntries
+	(*BackupManagerStatus)(nil),      // 38: server.BackupManagerStatus
+	(*BackupEntryRequest)(nil),       // 39: server.BackupEntryRequest
+	(*ExportedBackupResponse)(nil),   // 40: server.ExportedBackupResponse
+	(*ImportBackupRequest)(nil),      // 41: server.ImportBackupRequest
+	(*RestoreFromBackupRequest)(nil), // 42: server.RestoreFromBackupRequest
+	(*InitRequest)(nil),              // 43: server.InitRequest
+	(*InitResponse)(nil),             // 44: server.InitResponse
+	(*UnsealRequest)(nil),            // 45: server.UnsealRequest
+	(*SealStatus)(nil),               // 46: server.SealStatus
+	(*EmptyMessage)(nil),             // 47: server.EmptyMessage
+	(*ServerVersionRequest)(nil),     // 48: server.ServerVersionRequest
+	(*ServerVersionResponse)(nil),    // 49: server.ServerVersionResponse
 }
 var file_server_proto_depIdxs = []int32{
 	1,  // 0: server.FilePacket.options:type_name -> server.FileOptions
-	5,  // 1: server.GetKeysResponse.Entities:type_name -> server.Entity
-	16, // 2: server.PathResponse.Content:type_name -> server.ContentType
-	18, // 3: server.BackupEntries.Backups:type_name -> server.BackupEntry
-	25, // 4: server.OpenAbyss.GetKeyNames:input_type -> server.EmptyMessage
-	25, // 5: server.OpenAbyss.GetKeys:input_type -> server.EmptyMessage
-	8,  // 6: server.OpenAbyss.GenerateKeyPair:input_type -> server.GenerateEntityRequest
-	6,  // 7: server.OpenAbyss.ModifyKeyPair:input_type -> server.EntityModifyRequest
-	7,  // 8: server.OpenAbyss.RemoveKeyPair:input_type -> server.EntityRemoveRequest
-	0,  // 9: server.OpenAbyss.EncryptFile:input_type -> server.FilePacket
-	2,  // 10: server.OpenAbyss.DecryptFile:input_type -> server.DecryptRequest
-	11, // 11: server.OpenAbyss.ImportKey:input_type -> server.KeyImportRequest
-	13, // 12: server.OpenAbyss.ExportKey:input_type -> server.KeyExportRequest
-	4,  // 13: server.OpenAbyss.ModifyEntity:input_type -> server.EntityMod
-	15, // 14: server.OpenAbyss.ListPathContents:input_type -> server.ListPathContentRequest
-	25, // 15: server.OpenAbyss.ListInternalBackups:input_type -> server.EmptyMessage
-	25, // 16: server.OpenAbyss.InvokeNewStorageBackup:input_type -> server.EmptyMessage
-	25, // 17: server.OpenAbyss.GetBackupManagerConfig:input_type -> server.EmptyMessage
-	20, // 18: server.OpenAbyss.SetBackupManagerConfig:input_type -> server.BackupManagerStatus
-	21, // 19: server.OpenAbyss.DeleteBackup:input_type -> server.BackupEntryRequest
-	21, // 20: server.OpenAbyss.ExportBackup:input_type -> server.BackupEntryRequest
-	23, // 21: server.OpenAbyss.ImportBackup:input_type -> server.ImportBackupRequest
-	24, // 22: server.OpenAbyss.RestoreFromBackup:input_type -> server.RestoreFromBackupRequest
-	26, // 23: server.OpenAbyss.GetServerVersion:input_type -> server.ServerVersionRequest
-	10, // 24: server.OpenAbyss.GetKeyNames:output_type -> server.GetKeyNamesResponse
-	9,  // 25: server.OpenAbyss.GetKeys:output_type -> server.GetKeysResponse
-	5,  // 26: server.OpenAbyss.GenerateKeyPair:output_type -> server.Entity
-	5,  // 27: server.OpenAbyss.ModifyKeyPair:output_type -> server.Entity
-	5,  // 28: server.OpenAbyss.RemoveKeyPair:output_type -> server.Entity
-	3,  // 29: server.OpenAbyss.EncryptFile:output_type -> server.EncryptResult
-	0,  // 30: server.OpenAbyss.DecryptFile:output_type -> server.FilePacket
-	12, // 31: server.OpenAbyss.ImportKey:output_type -> server.KeyImportResponse
-	14, // 32: server.OpenAbyss.ExportKey:output_type -> server.KeyExportResponse
-	25, // 33: server.OpenAbyss.ModifyEntity:output_type -> server.EmptyMessage
-	17, // 34: server.OpenAbyss.ListPathContents:output_type -> server.PathResponse
-	19, // 35: server.OpenAbyss.ListInternalBackups:output_type -> server.BackupEntries
-	18, // 36: server.OpenAbyss.InvokeNewStorageBackup:output_type -> server.BackupEntry
-	20, // 37: server.OpenAbyss.GetBackupManagerConfig:output_type -> server.BackupManagerStatus
-	20, // 38: server.OpenAbyss.SetBackupManagerConfig:output_type -> server.BackupManagerStatus
-	18, // 39: server.OpenAbyss.DeleteBackup:output_type -> server.BackupEntry
-	22, // 40: server.OpenAbyss.ExportBackup:output_type -> server.ExportedBackupResponse
-	25, // 41: server.OpenAbyss.ImportBackup:output_type -> server.EmptyMessage
-	18, // 42: server.OpenAbyss.RestoreFromBackup:output_type -> server.BackupEntry
-	27, // 43: server.OpenAbyss.GetServerVersion:output_type -> server.ServerVersionResponse
-	24, // [24:44] is the sub-list for method output_type
-	4,  // [4:24] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 1: server.FileStreamHeader.options:type_name -> server.FileOptions
+	3,  // 2: server.FileStreamPacket.Header:type_name -> server.FileStreamHeader
+	12, // 3: server.Entity.Versions:type_name -> server.EntityKeyVersion
+	11, // 4: server.Entity.StateTransitions:type_name -> server.EntityKeyStateTransition
+	10, // 5: server.Entity.RotationPolicy:type_name -> server.EntityRotationPolicy
+	10, // 6: server.EntityModifyRequest.RotationPolicy:type_name -> server.EntityRotationPolicy
+	9,  // 7: server.EntityRemoveResponse.Entity:type_name -> server.Entity
+	10, // 8: server.GenerateEntityRequest.RotationPolicy:type_name -> server.EntityRotationPolicy
+	9,  // 9: server.GetKeysResponse.Entities:type_name -> server.Entity
+	25, // 10: server.KeyAlgorithmsResponse.Algorithms:type_name -> server.KeyAlgorithm
+	26, // 11: server.KeyAlgorithmsResponse.Ciphers:type_name -> server.CipherAlgorithm
+	34, // 12: server.PathResponse.Content:type_name -> server.ContentType
+	36, // 13: server.BackupEntries.Backups:type_name -> server.BackupEntry
+	47, // 14: server.OpenAbyss.GetKeyNames:input_type -> server.EmptyMessage
+	47, // 15: server.OpenAbyss.GetKeys:input_type -> server.EmptyMessage
+	47, // 16: server.OpenAbyss.GetKeyAlgorithms:input_type -> server.EmptyMessage
+	13, // 17: server.OpenAbyss.GetPublicKey:input_type -> server.PublicKeyRequest
+	22, // 18: server.OpenAbyss.GenerateKeyPair:input_type -> server.GenerateEntityRequest
+	15, // 19: server.OpenAbyss.ModifyKeyPair:input_type -> server.EntityModifyRequest
+	16, // 20: server.OpenAbyss.RemoveKeyPair:input_type -> server.EntityRemoveRequest
+	20, // 21: server.OpenAbyss.RotateKey:input_type -> server.KeyRotationRequest
+	18, // 22: server.OpenAbyss.ModifyKeyVersion:input_type -> server.KeyVersionModifyRequest
+	19, // 23: server.OpenAbyss.EnableKey:input_type -> server.KeyStateRequest
+	19, // 24: server.OpenAbyss.DisableKey:input_type -> server.KeyStateRequest
+	19, // 25: server.OpenAbyss.DestroyKey:input_type -> server.KeyStateRequest
+	0,  // 26: server.OpenAbyss.EncryptFile:input_type -> server.FilePacket
+	2,  // 27: server.OpenAbyss.DecryptFile:input_type -> server.DecryptRequest
+	4,  // 28: server.OpenAbyss.EncryptFileStream:input_type -> server.FileStreamPacket
+	2,  // 29: server.OpenAbyss.DecryptFileStream:input_type -> server.DecryptRequest
+	28, // 30: server.OpenAbyss.ImportKey:input_type -> server.KeyImportRequest
+	30, // 31: server.OpenAbyss.ExportKey:input_type -> server.KeyExportRequest
+	47, // 32: server.OpenAbyss.GetTransportKey:input_type -> server.EmptyMessage
+	6,  // 33: server.OpenAbyss.ModifyEntity:input_type -> server.EntityMod
+	7,  // 34: server.OpenAbyss.MigrateStorageCipher:input_type -> server.CipherMigrationRequest
+	33, // 35: server.OpenAbyss.ListPathContents:input_type -> server.ListPathContentRequest
+	47, // 36: server.OpenAbyss.ListInternalBackups:input_type -> server.EmptyMessage
+	47, // 37: server.OpenAbyss.InvokeNewStorageBackup:input_type -> server.EmptyMessage
+	47, // 38: server.OpenAbyss.GetBackupManagerConfig:input_type -> server.EmptyMessage
+	38, // 39: server.OpenAbyss.SetBackupManagerConfig:input_type -> server.BackupManagerStatus
+	39, // 40: server.OpenAbyss.DeleteBackup:input_type -> server.BackupEntryRequest
+	39, // 41: server.OpenAbyss.ExportBackup:input_type -> server.BackupEntryRequest
+	41, // 42: server.OpenAbyss.ImportBackup:input_type -> server.ImportBackupRequest
+	42, // 43: server.OpenAbyss.RestoreFromBackup:input_type -> server.RestoreFromBackupRequest
+	43, // 44: server.OpenAbyss.Init:input_type -> server.InitRequest
+	45, // 45: server.OpenAbyss.Unseal:input_type -> server.UnsealRequest
+	47, // 46: server.OpenAbyss.Seal:input_type -> server.EmptyMessage
+	47, // 47: server.OpenAbyss.GetSealStatus:input_type -> server.EmptyMessage
+	48, // 48: server.OpenAbyss.GetServerVersion:input_type -> server.ServerVersionRequest
+	24, // 49: server.OpenAbyss.GetKeyNames:output_type -> server.GetKeyNamesResponse
+	23, // 50: server.OpenAbyss.GetKeys:output_type -> server.GetKeysResponse
+	27, // 51: server.OpenAbyss.GetKeyAlgorithms:output_type -> server.KeyAlgorithmsResponse
+	14, // 52: server.OpenAbyss.GetPublicKey:output_type -> server.PublicKey
+	9,  // 53: server.OpenAbyss.GenerateKeyPair:output_type -> server.Entity
+	9,  // 54: server.OpenAbyss.ModifyKeyPair:output_type -> server.Entity
+	17, // 55: server.OpenAbyss.RemoveKeyPair:output_type -> server.EntityRemoveResponse
+	21, // 56: server.OpenAbyss.RotateKey:output_type -> server.KeyRotationProgress
+	9,  // 57: server.OpenAbyss.ModifyKeyVersion:output_type -> server.Entity
+	9,  // 58: server.OpenAbyss.EnableKey:output_type -> server.Entity
+	9,  // 59: server.OpenAbyss.DisableKey:output_type -> server.Entity
+	9,  // 60: server.OpenAbyss.DestroyKey:output_type -> server.Entity
+	5,  // 61: server.OpenAbyss.EncryptFile:output_type -> server.EncryptResult
+	0,  // 62: server.OpenAbyss.DecryptFile:output_type -> server.FilePacket
+	5,  // 63: server.OpenAbyss.EncryptFileStream:output_type -> server.EncryptResult
+	4,  // 64: server.OpenAbyss.DecryptFileStream:output_type -> server.FileStreamPacket
+	29, // 65: server.OpenAbyss.ImportKey:output_type -> server.KeyImportResponse
+	32, // 66: server.OpenAbyss.ExportKey:output_type -> server.KeyExportResponse
+	31, // 67: server.OpenAbyss.GetTransportKey:output_type -> server.TransportKey
+	47, // 68: server.OpenAbyss.ModifyEntity:output_type -> server.EmptyMessage
+	8,  // 69: server.OpenAbyss.MigrateStorageCipher:output_type -> server.CipherMigrationResponse
+	35, // 70: server.OpenAbyss.ListPathContents:output_type -> server.PathResponse
+	37, // 71: server.OpenAbyss.ListInternalBackups:output_type -> server.BackupEntries
+	36, // 72: server.OpenAbyss.InvokeNewStorageBackup:output_type -> server.BackupEntry
+	38, // 73: server.OpenAbyss.GetBackupManagerConfig:output_type -> server.BackupManagerStatus
+	38, // 74: server.OpenAbyss.SetBackupManagerConfig:output_type -> server.BackupManagerStatus
+	36, // 75: server.OpenAbyss.DeleteBackup:output_type -> server.BackupEntry
+	40, // 76: server.OpenAbyss.ExportBackup:output_type -> server.ExportedBackupResponse
+	47, // 77: server.OpenAbyss.ImportBackup:output_type -> server.EmptyMessage
+	36, // 78: server.OpenAbyss.RestoreFromBackup:output_type -> server.BackupEntry
+	44, // 79: server.OpenAbyss.Init:output_type -> server.InitResponse
+	46, // 80: server.OpenAbyss.Unseal:output_type -> server.SealStatus
+	46, // 81: server.OpenAbyss.Seal:output_type -> server.SealStatus
+	46, // 82: server.OpenAbyss.GetSealStatus:output_type -> server.SealStatus
+	49, // 83: server.OpenAbyss.GetServerVersion:output_type -> server.ServerVersionResponse
+	49, // [49:84] is the sub-list for method output_type
+	14, // [14:49] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_server_proto_init() }
@@ -2047,8 +4023,32 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileOptions); i {
+		file_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileStreamHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2059,8 +4059,8 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptRequest); i {
+		file_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileStreamPacket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2071,7 +4071,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EncryptResult); i {
 			case 0:
 				return &v.state
@@ -2083,7 +4083,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EntityMod); i {
 			case 0:
 				return &v.state
@@ -2095,7 +4095,31 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CipherMigrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CipherMigrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Entity); i {
 			case 0:
 				return &v.state
@@ -2107,7 +4131,67 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRotationPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityKeyStateTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityKeyVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EntityModifyRequest); i {
 			case 0:
 				return &v.state
@@ -2119,7 +4203,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EntityRemoveRequest); i {
 			case 0:
 				return &v.state
@@ -2131,7 +4215,67 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyVersionModifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotationProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateEntityRequest); i {
 			case 0:
 				return &v.state
@@ -2143,7 +4287,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetKeysResponse); i {
 			case 0:
 				return &v.state
@@ -2155,7 +4299,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetKeyNamesResponse); i {
 			case 0:
 				return &v.state
@@ -2167,7 +4311,43 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyAlgorithm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CipherAlgorithm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyAlgorithmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyImportRequest); i {
 			case 0:
 				return &v.state
@@ -2179,7 +4359,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyImportResponse); i {
 			case 0:
 				return &v.state
@@ -2191,7 +4371,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyExportRequest); i {
 			case 0:
 				return &v.state
@@ -2203,7 +4383,19 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransportKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyExportResponse); i {
 			case 0:
 				return &v.state
@@ -2215,7 +4407,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPathContentRequest); i {
 			case 0:
 				return &v.state
@@ -2227,7 +4419,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContentType); i {
 			case 0:
 				return &v.state
@@ -2239,7 +4431,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PathResponse); i {
 			case 0:
 				return &v.state
@@ -2251,7 +4443,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupEntry); i {
 			case 0:
 				return &v.state
@@ -2263,7 +4455,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupEntries); i {
 			case 0:
 				return &v.state
@@ -2275,7 +4467,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupManagerStatus); i {
 			case 0:
 				return &v.state
@@ -2287,7 +4479,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupEntryRequest); i {
 			case 0:
 				return &v.state
@@ -2299,7 +4491,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExportedBackupResponse); i {
 			case 0:
 				return &v.state
@@ -2311,7 +4503,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportBackupRequest); i {
 			case 0:
 				return &v.state
@@ -2323,7 +4515,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestoreFromBackupRequest); i {
 			case 0:
 				return &v.state
@@ -2335,7 +4527,55 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyMessage); i {
 			case 0:
 				return &v.state
@@ -2347,7 +4587,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerVersionRequest); i {
 			case 0:
 				return &v.state
@@ -2359,7 +4599,7 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerVersionResponse); i {
 			case 0:
 				return &v.state
@@ -2372,13 +4612,18 @@ func file_server_proto_init() {
 			}
 		}
 	}
+	file_server_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*FileStreamPacket_Header)(nil),
+		(*FileStreamPacket_Chunk)(nil),
+		(*FileStreamPacket_FileSignature)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
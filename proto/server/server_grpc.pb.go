@@ -22,19 +22,37 @@ type OpenAbyssClient interface {
 	GetKeyNames(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*GetKeyNamesResponse, error)
 	// Obtains Stored Public Keys
 	GetKeys(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*GetKeysResponse, error)
+	// Obtains the key algorithms supported by the server
+	GetKeyAlgorithms(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*KeyAlgorithmsResponse, error)
+	// Obtains a key version's public key in a standard format, along with its fingerprint
+	GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKey, error)
 	// Generates new Keypair
 	GenerateKeyPair(ctx context.Context, in *GenerateEntityRequest, opts ...grpc.CallOption) (*Entity, error)
 	// Modify/Remove keypair
 	ModifyKeyPair(ctx context.Context, in *EntityModifyRequest, opts ...grpc.CallOption) (*Entity, error)
-	RemoveKeyPair(ctx context.Context, in *EntityRemoveRequest, opts ...grpc.CallOption) (*Entity, error)
+	RemoveKeyPair(ctx context.Context, in *EntityRemoveRequest, opts ...grpc.CallOption) (*EntityRemoveResponse, error)
+	// Rotates keypair's material, streaming each stored file's progress
+	RotateKey(ctx context.Context, in *KeyRotationRequest, opts ...grpc.CallOption) (OpenAbyss_RotateKeyClient, error)
+	// Enable/Disable/Destroy a keypair's version
+	ModifyKeyVersion(ctx context.Context, in *KeyVersionModifyRequest, opts ...grpc.CallOption) (*Entity, error)
+	// Enable (active or decrypt-only)/Disable/Destroy a keypair, moving it through its lifecycle
+	EnableKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error)
+	DisableKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error)
+	DestroyKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error)
 	// Encrypt/Decrypt File
 	EncryptFile(ctx context.Context, in *FilePacket, opts ...grpc.CallOption) (*EncryptResult, error)
 	DecryptFile(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*FilePacket, error)
+	// Encrypt/Decrypt File Streams (Header packet followed by file chunks)
+	EncryptFileStream(ctx context.Context, opts ...grpc.CallOption) (OpenAbyss_EncryptFileStreamClient, error)
+	DecryptFileStream(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (OpenAbyss_DecryptFileStreamClient, error)
 	// Import/Export Keys
 	ImportKey(ctx context.Context, in *KeyImportRequest, opts ...grpc.CallOption) (*KeyImportResponse, error)
 	ExportKey(ctx context.Context, in *KeyExportRequest, opts ...grpc.CallOption) (*KeyExportResponse, error)
+	// Obtains the server's transport public key, receiving keys transferred from other servers
+	GetTransportKey(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*TransportKey, error)
 	// Internal FileStorage Mods
 	ModifyEntity(ctx context.Context, in *EntityMod, opts ...grpc.CallOption) (*EmptyMessage, error)
+	MigrateStorageCipher(ctx context.Context, in *CipherMigrationRequest, opts ...grpc.CallOption) (*CipherMigrationResponse, error)
 	// Lists stored path contents
 	ListPathContents(ctx context.Context, in *ListPathContentRequest, opts ...grpc.CallOption) (*PathResponse, error)
 	ListInternalBackups(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*BackupEntries, error)
@@ -46,6 +64,11 @@ type OpenAbyssClient interface {
 	ExportBackup(ctx context.Context, in *BackupEntryRequest, opts ...grpc.CallOption) (*ExportedBackupResponse, error)
 	ImportBackup(ctx context.Context, in *ImportBackupRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	RestoreFromBackup(ctx context.Context, in *RestoreFromBackupRequest, opts ...grpc.CallOption) (*BackupEntry, error)
+	// Operator: Splits the master key into unseal shares, seals & unseals the server
+	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*SealStatus, error)
+	Seal(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SealStatus, error)
+	GetSealStatus(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SealStatus, error)
 	// Misc
 	GetServerVersion(ctx context.Context, in *ServerVersionRequest, opts ...grpc.CallOption) (*ServerVersionResponse, error)
 }
@@ -76,6 +99,24 @@ func (c *openAbyssClient) GetKeys(ctx context.Context, in *EmptyMessage, opts ..
 	return out, nil
 }
 
+func (c *openAbyssClient) GetKeyAlgorithms(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*KeyAlgorithmsResponse, error) {
+	out := new(KeyAlgorithmsResponse)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GetKeyAlgorithms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*PublicKey, error) {
+	out := new(PublicKey)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GetPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openAbyssClient) GenerateKeyPair(ctx context.Context, in *GenerateEntityRequest, opts ...grpc.CallOption) (*Entity, error) {
 	out := new(Entity)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GenerateKeyPair", in, out, opts...)
@@ -94,8 +135,8 @@ func (c *openAbyssClient) ModifyKeyPair(ctx context.Context, in *EntityModifyReq
 	return out, nil
 }
 
-func (c *openAbyssClient) RemoveKeyPair(ctx context.Context, in *EntityRemoveRequest, opts ...grpc.CallOption) (*Entity, error) {
-	out := new(Entity)
+func (c *openAbyssClient) RemoveKeyPair(ctx context.Context, in *EntityRemoveRequest, opts ...grpc.CallOption) (*EntityRemoveResponse, error) {
+	out := new(EntityRemoveResponse)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/RemoveKeyPair", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -103,6 +144,74 @@ func (c *openAbyssClient) RemoveKeyPair(ctx context.Context, in *EntityRemoveReq
 	return out, nil
 }
 
+func (c *openAbyssClient) RotateKey(ctx context.Context, in *KeyRotationRequest, opts ...grpc.CallOption) (OpenAbyss_RotateKeyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OpenAbyss_ServiceDesc.Streams[0], "/server.OpenAbyss/RotateKey", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openAbyssRotateKeyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OpenAbyss_RotateKeyClient interface {
+	Recv() (*KeyRotationProgress, error)
+	grpc.ClientStream
+}
+
+type openAbyssRotateKeyClient struct {
+	grpc.ClientStream
+}
+
+func (x *openAbyssRotateKeyClient) Recv() (*KeyRotationProgress, error) {
+	m := new(KeyRotationProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *openAbyssClient) ModifyKeyVersion(ctx context.Context, in *KeyVersionModifyRequest, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/ModifyKeyVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) EnableKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/EnableKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) DisableKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/DisableKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) DestroyKey(ctx context.Context, in *KeyStateRequest, opts ...grpc.CallOption) (*Entity, error) {
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/DestroyKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openAbyssClient) EncryptFile(ctx context.Context, in *FilePacket, opts ...grpc.CallOption) (*EncryptResult, error) {
 	out := new(EncryptResult)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/EncryptFile", in, out, opts...)
@@ -121,6 +230,72 @@ func (c *openAbyssClient) DecryptFile(ctx context.Context, in *DecryptRequest, o
 	return out, nil
 }
 
+func (c *openAbyssClient) EncryptFileStream(ctx context.Context, opts ...grpc.CallOption) (OpenAbyss_EncryptFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OpenAbyss_ServiceDesc.Streams[1], "/server.OpenAbyss/EncryptFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openAbyssEncryptFileStreamClient{stream}
+	return x, nil
+}
+
+type OpenAbyss_EncryptFileStreamClient interface {
+	Send(*FileStreamPacket) error
+	CloseAndRecv() (*EncryptResult, error)
+	grpc.ClientStream
+}
+
+type openAbyssEncryptFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *openAbyssEncryptFileStreamClient) Send(m *FileStreamPacket) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *openAbyssEncryptFileStreamClient) CloseAndRecv() (*EncryptResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(EncryptResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *openAbyssClient) DecryptFileStream(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (OpenAbyss_DecryptFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OpenAbyss_ServiceDesc.Streams[2], "/server.OpenAbyss/DecryptFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &openAbyssDecryptFileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OpenAbyss_DecryptFileStreamClient interface {
+	Recv() (*FileStreamPacket, error)
+	grpc.ClientStream
+}
+
+type openAbyssDecryptFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *openAbyssDecryptFileStreamClient) Recv() (*FileStreamPacket, error) {
+	m := new(FileStreamPacket)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *openAbyssClient) ImportKey(ctx context.Context, in *KeyImportRequest, opts ...grpc.CallOption) (*KeyImportResponse, error) {
 	out := new(KeyImportResponse)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/ImportKey", in, out, opts...)
@@ -139,6 +314,15 @@ func (c *openAbyssClient) ExportKey(ctx context.Context, in *KeyExportRequest, o
 	return out, nil
 }
 
+func (c *openAbyssClient) GetTransportKey(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*TransportKey, error) {
+	out := new(TransportKey)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GetTransportKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openAbyssClient) ModifyEntity(ctx context.Context, in *EntityMod, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/ModifyEntity", in, out, opts...)
@@ -148,6 +332,15 @@ func (c *openAbyssClient) ModifyEntity(ctx context.Context, in *EntityMod, opts
 	return out, nil
 }
 
+func (c *openAbyssClient) MigrateStorageCipher(ctx context.Context, in *CipherMigrationRequest, opts ...grpc.CallOption) (*CipherMigrationResponse, error) {
+	out := new(CipherMigrationResponse)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/MigrateStorageCipher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openAbyssClient) ListPathContents(ctx context.Context, in *ListPathContentRequest, opts ...grpc.CallOption) (*PathResponse, error) {
 	out := new(PathResponse)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/ListPathContents", in, out, opts...)
@@ -229,6 +422,42 @@ func (c *openAbyssClient) RestoreFromBackup(ctx context.Context, in *RestoreFrom
 	return out, nil
 }
 
+func (c *openAbyssClient) Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error) {
+	out := new(InitResponse)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*SealStatus, error) {
+	out := new(SealStatus)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/Unseal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) Seal(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SealStatus, error) {
+	out := new(SealStatus)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/Seal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openAbyssClient) GetSealStatus(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SealStatus, error) {
+	out := new(SealStatus)
+	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GetSealStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openAbyssClient) GetServerVersion(ctx context.Context, in *ServerVersionRequest, opts ...grpc.CallOption) (*ServerVersionResponse, error) {
 	out := new(ServerVersionResponse)
 	err := c.cc.Invoke(ctx, "/server.OpenAbyss/GetServerVersion", in, out, opts...)
@@ -246,19 +475,37 @@ type OpenAbyssServer interface {
 	GetKeyNames(context.Context, *EmptyMessage) (*GetKeyNamesResponse, error)
 	// Obtains Stored Public Keys
 	GetKeys(context.Context, *EmptyMessage) (*GetKeysResponse, error)
+	// Obtains the key algorithms supported by the server
+	GetKeyAlgorithms(context.Context, *EmptyMessage) (*KeyAlgorithmsResponse, error)
+	// Obtains a key version's public key in a standard format, along with its fingerprint
+	GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKey, error)
 	// Generates new Keypair
 	GenerateKeyPair(context.Context, *GenerateEntityRequest) (*Entity, error)
 	// Modify/Remove keypair
 	ModifyKeyPair(context.Context, *EntityModifyRequest) (*Entity, error)
-	RemoveKeyPair(context.Context, *EntityRemoveRequest) (*Entity, error)
+	RemoveKeyPair(context.Context, *EntityRemoveRequest) (*EntityRemoveResponse, error)
+	// Rotates keypair's material, streaming each stored file's progress
+	RotateKey(*KeyRotationRequest, OpenAbyss_RotateKeyServer) error
+	// Enable/Disable/Destroy a keypair's version
+	ModifyKeyVersion(context.Context, *KeyVersionModifyRequest) (*Entity, error)
+	// Enable (active or decrypt-only)/Disable/Destroy a keypair, moving it through its lifecycle
+	EnableKey(context.Context, *KeyStateRequest) (*Entity, error)
+	DisableKey(context.Context, *KeyStateRequest) (*Entity, error)
+	DestroyKey(context.Context, *KeyStateRequest) (*Entity, error)
 	// Encrypt/Decrypt File
 	EncryptFile(context.Context, *FilePacket) (*EncryptResult, error)
 	DecryptFile(context.Context, *DecryptRequest) (*FilePacket, error)
+	// Encrypt/Decrypt File Streams (Header packet followed by file chunks)
+	EncryptFileStream(OpenAbyss_EncryptFileStreamServer) error
+	DecryptFileStream(*DecryptRequest, OpenAbyss_DecryptFileStreamServer) error
 	// Import/Export Keys
 	ImportKey(context.Context, *KeyImportRequest) (*KeyImportResponse, error)
 	ExportKey(context.Context, *KeyExportRequest) (*KeyExportResponse, error)
+	// Obtains the server's transport public key, receiving keys transferred from other servers
+	GetTransportKey(context.Context, *EmptyMessage) (*TransportKey, error)
 	// Internal FileStorage Mods
 	ModifyEntity(context.Context, *EntityMod) (*EmptyMessage, error)
+	MigrateStorageCipher(context.Context, *CipherMigrationRequest) (*CipherMigrationResponse, error)
 	// Lists stored path contents
 	ListPathContents(context.Context, *ListPathContentRequest) (*PathResponse, error)
 	ListInternalBackups(context.Context, *EmptyMessage) (*BackupEntries, error)
@@ -270,6 +517,11 @@ type OpenAbyssServer interface {
 	ExportBackup(context.Context, *BackupEntryRequest) (*ExportedBackupResponse, error)
 	ImportBackup(context.Context, *ImportBackupRequest) (*EmptyMessage, error)
 	RestoreFromBackup(context.Context, *RestoreFromBackupRequest) (*BackupEntry, error)
+	// Operator: Splits the master key into unseal shares, seals & unseals the server
+	Init(context.Context, *InitRequest) (*InitResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*SealStatus, error)
+	Seal(context.Context, *EmptyMessage) (*SealStatus, error)
+	GetSealStatus(context.Context, *EmptyMessage) (*SealStatus, error)
 	// Misc
 	GetServerVersion(context.Context, *ServerVersionRequest) (*ServerVersionResponse, error)
 	mustEmbedUnimplementedOpenAbyssServer()
@@ -285,30 +537,63 @@ func (UnimplementedOpenAbyssServer) GetKeyNames(context.Context, *EmptyMessage)
 func (UnimplementedOpenAbyssServer) GetKeys(context.Context, *EmptyMessage) (*GetKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeys not implemented")
 }
+func (UnimplementedOpenAbyssServer) GetKeyAlgorithms(context.Context, *EmptyMessage) (*KeyAlgorithmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyAlgorithms not implemented")
+}
+func (UnimplementedOpenAbyssServer) GetPublicKey(context.Context, *PublicKeyRequest) (*PublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
 func (UnimplementedOpenAbyssServer) GenerateKeyPair(context.Context, *GenerateEntityRequest) (*Entity, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateKeyPair not implemented")
 }
 func (UnimplementedOpenAbyssServer) ModifyKeyPair(context.Context, *EntityModifyRequest) (*Entity, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyKeyPair not implemented")
 }
-func (UnimplementedOpenAbyssServer) RemoveKeyPair(context.Context, *EntityRemoveRequest) (*Entity, error) {
+func (UnimplementedOpenAbyssServer) RemoveKeyPair(context.Context, *EntityRemoveRequest) (*EntityRemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeyPair not implemented")
 }
+func (UnimplementedOpenAbyssServer) RotateKey(*KeyRotationRequest, OpenAbyss_RotateKeyServer) error {
+	return status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
+func (UnimplementedOpenAbyssServer) ModifyKeyVersion(context.Context, *KeyVersionModifyRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyKeyVersion not implemented")
+}
+func (UnimplementedOpenAbyssServer) EnableKey(context.Context, *KeyStateRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableKey not implemented")
+}
+func (UnimplementedOpenAbyssServer) DisableKey(context.Context, *KeyStateRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableKey not implemented")
+}
+func (UnimplementedOpenAbyssServer) DestroyKey(context.Context, *KeyStateRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyKey not implemented")
+}
 func (UnimplementedOpenAbyssServer) EncryptFile(context.Context, *FilePacket) (*EncryptResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EncryptFile not implemented")
 }
 func (UnimplementedOpenAbyssServer) DecryptFile(context.Context, *DecryptRequest) (*FilePacket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecryptFile not implemented")
 }
+func (UnimplementedOpenAbyssServer) EncryptFileStream(OpenAbyss_EncryptFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EncryptFileStream not implemented")
+}
+func (UnimplementedOpenAbyssServer) DecryptFileStream(*DecryptRequest, OpenAbyss_DecryptFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecryptFileStream not implemented")
+}
 func (UnimplementedOpenAbyssServer) ImportKey(context.Context, *KeyImportRequest) (*KeyImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportKey not implemented")
 }
 func (UnimplementedOpenAbyssServer) ExportKey(context.Context, *KeyExportRequest) (*KeyExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportKey not implemented")
 }
+func (UnimplementedOpenAbyssServer) GetTransportKey(context.Context, *EmptyMessage) (*TransportKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransportKey not implemented")
+}
 func (UnimplementedOpenAbyssServer) ModifyEntity(context.Context, *EntityMod) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyEntity not implemented")
 }
+func (UnimplementedOpenAbyssServer) MigrateStorageCipher(context.Context, *CipherMigrationRequest) (*CipherMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateStorageCipher not implemented")
+}
 func (UnimplementedOpenAbyssServer) ListPathContents(context.Context, *ListPathContentRequest) (*PathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPathContents not implemented")
 }
@@ -336,6 +621,18 @@ func (UnimplementedOpenAbyssServer) ImportBackup(context.Context, *ImportBackupR
 func (UnimplementedOpenAbyssServer) RestoreFromBackup(context.Context, *RestoreFromBackupRequest) (*BackupEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
 }
+func (UnimplementedOpenAbyssServer) Init(context.Context, *InitRequest) (*InitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedOpenAbyssServer) Unseal(context.Context, *UnsealRequest) (*SealStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (UnimplementedOpenAbyssServer) Seal(context.Context, *EmptyMessage) (*SealStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (UnimplementedOpenAbyssServer) GetSealStatus(context.Context, *EmptyMessage) (*SealStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSealStatus not implemented")
+}
 func (UnimplementedOpenAbyssServer) GetServerVersion(context.Context, *ServerVersionRequest) (*ServerVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
 }
@@ -388,6 +685,42 @@ func _OpenAbyss_GetKeys_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_GetKeyAlgorithms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).GetKeyAlgorithms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/GetKeyAlgorithms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).GetKeyAlgorithms(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/GetPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).GetPublicKey(ctx, req.(*PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenAbyss_GenerateKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateEntityRequest)
 	if err := dec(in); err != nil {
@@ -442,6 +775,99 @@ func _OpenAbyss_RemoveKeyPair_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_RotateKey_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KeyRotationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenAbyssServer).RotateKey(m, &openAbyssRotateKeyServer{stream})
+}
+
+type OpenAbyss_RotateKeyServer interface {
+	Send(*KeyRotationProgress) error
+	grpc.ServerStream
+}
+
+type openAbyssRotateKeyServer struct {
+	grpc.ServerStream
+}
+
+func (x *openAbyssRotateKeyServer) Send(m *KeyRotationProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OpenAbyss_ModifyKeyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyVersionModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).ModifyKeyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/ModifyKeyVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).ModifyKeyVersion(ctx, req.(*KeyVersionModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_EnableKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).EnableKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/EnableKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).EnableKey(ctx, req.(*KeyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_DisableKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).DisableKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/DisableKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).DisableKey(ctx, req.(*KeyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_DestroyKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).DestroyKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/DestroyKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).DestroyKey(ctx, req.(*KeyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenAbyss_EncryptFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FilePacket)
 	if err := dec(in); err != nil {
@@ -478,6 +904,53 @@ func _OpenAbyss_DecryptFile_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_EncryptFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OpenAbyssServer).EncryptFileStream(&openAbyssEncryptFileStreamServer{stream})
+}
+
+type OpenAbyss_EncryptFileStreamServer interface {
+	SendAndClose(*EncryptResult) error
+	Recv() (*FileStreamPacket, error)
+	grpc.ServerStream
+}
+
+type openAbyssEncryptFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *openAbyssEncryptFileStreamServer) SendAndClose(m *EncryptResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *openAbyssEncryptFileStreamServer) Recv() (*FileStreamPacket, error) {
+	m := new(FileStreamPacket)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OpenAbyss_DecryptFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DecryptRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpenAbyssServer).DecryptFileStream(m, &openAbyssDecryptFileStreamServer{stream})
+}
+
+type OpenAbyss_DecryptFileStreamServer interface {
+	Send(*FileStreamPacket) error
+	grpc.ServerStream
+}
+
+type openAbyssDecryptFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *openAbyssDecryptFileStreamServer) Send(m *FileStreamPacket) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _OpenAbyss_ImportKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KeyImportRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +987,24 @@ func _OpenAbyss_ExportKey_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_GetTransportKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).GetTransportKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/GetTransportKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).GetTransportKey(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenAbyss_ModifyEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EntityMod)
 	if err := dec(in); err != nil {
@@ -532,6 +1023,24 @@ func _OpenAbyss_ModifyEntity_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_MigrateStorageCipher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CipherMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).MigrateStorageCipher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/MigrateStorageCipher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).MigrateStorageCipher(ctx, req.(*CipherMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenAbyss_ListPathContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPathContentRequest)
 	if err := dec(in); err != nil {
@@ -694,6 +1203,78 @@ func _OpenAbyss_RestoreFromBackup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenAbyss_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).Init(ctx, req.(*InitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).Seal(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenAbyss_GetSealStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenAbyssServer).GetSealStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.OpenAbyss/GetSealStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenAbyssServer).GetSealStatus(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenAbyss_GetServerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ServerVersionRequest)
 	if err := dec(in); err != nil {
@@ -727,6 +1308,14 @@ var OpenAbyss_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeys",
 			Handler:    _OpenAbyss_GetKeys_Handler,
 		},
+		{
+			MethodName: "GetKeyAlgorithms",
+			Handler:    _OpenAbyss_GetKeyAlgorithms_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _OpenAbyss_GetPublicKey_Handler,
+		},
 		{
 			MethodName: "GenerateKeyPair",
 			Handler:    _OpenAbyss_GenerateKeyPair_Handler,
@@ -739,6 +1328,22 @@ var OpenAbyss_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveKeyPair",
 			Handler:    _OpenAbyss_RemoveKeyPair_Handler,
 		},
+		{
+			MethodName: "ModifyKeyVersion",
+			Handler:    _OpenAbyss_ModifyKeyVersion_Handler,
+		},
+		{
+			MethodName: "EnableKey",
+			Handler:    _OpenAbyss_EnableKey_Handler,
+		},
+		{
+			MethodName: "DisableKey",
+			Handler:    _OpenAbyss_DisableKey_Handler,
+		},
+		{
+			MethodName: "DestroyKey",
+			Handler:    _OpenAbyss_DestroyKey_Handler,
+		},
 		{
 			MethodName: "EncryptFile",
 			Handler:    _OpenAbyss_EncryptFile_Handler,
@@ -755,10 +1360,18 @@ var OpenAbyss_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportKey",
 			Handler:    _OpenAbyss_ExportKey_Handler,
 		},
+		{
+			MethodName: "GetTransportKey",
+			Handler:    _OpenAbyss_GetTransportKey_Handler,
+		},
 		{
 			MethodName: "ModifyEntity",
 			Handler:    _OpenAbyss_ModifyEntity_Handler,
 		},
+		{
+			MethodName: "MigrateStorageCipher",
+			Handler:    _OpenAbyss_MigrateStorageCipher_Handler,
+		},
 		{
 			MethodName: "ListPathContents",
 			Handler:    _OpenAbyss_ListPathContents_Handler,
@@ -795,11 +1408,43 @@ var OpenAbyss_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreFromBackup",
 			Handler:    _OpenAbyss_RestoreFromBackup_Handler,
 		},
+		{
+			MethodName: "Init",
+			Handler:    _OpenAbyss_Init_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _OpenAbyss_Unseal_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _OpenAbyss_Seal_Handler,
+		},
+		{
+			MethodName: "GetSealStatus",
+			Handler:    _OpenAbyss_GetSealStatus_Handler,
+		},
 		{
 			MethodName: "GetServerVersion",
 			Handler:    _OpenAbyss_GetServerVersion_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RotateKey",
+			Handler:       _OpenAbyss_RotateKey_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EncryptFileStream",
+			Handler:       _OpenAbyss_EncryptFileStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DecryptFileStream",
+			Handler:       _OpenAbyss_DecryptFileStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "server.proto",
 }
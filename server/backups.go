@@ -6,6 +6,7 @@ import (
 	"io/fs"
 	"io/ioutil"
 	"log"
+	"openabyss/entity"
 	pb "openabyss/proto/server"
 	"openabyss/server/configuration"
 	"openabyss/server/storage"
@@ -231,6 +232,15 @@ func (s openabyss_server) RestoreFromBackup(ctx context.Context, in *pb.RestoreF
 		return &pb.BackupEntry{}, fmt.Errorf("backup '%s' doesn't exist", in.FileName)
 	}
 
+	// Verify backup is sealed using the current master key prior to clearing storage
+	if backupMasterKey, err := storage.ReadBackupMasterKey(backup_path); err != nil {
+		log.Printf("[rpc_restore_backup]: Failed to read backup '%s': %v\n", in.FileName, err)
+		return &pb.BackupEntry{}, fmt.Errorf("failed to read backup '%s'", in.FileName)
+	} else if backupMasterKey != nil && !entity.VerifyMasterKey(backupMasterKey.Check) {
+		log.Printf("[rpc_restore_backup]: Backup '%s' sealed using another master key\n", in.FileName)
+		return &pb.BackupEntry{}, fmt.Errorf("backup '%s' sealed using another master key", in.FileName)
+	}
+
 	// Invoke Backup
 	backupEntry, err := s.InvokeNewStorageBackup(ctx, &pb.EmptyMessage{})
 	if err != nil {
@@ -284,8 +294,19 @@ func (s openabyss_server) RestoreFromBackup(ctx context.Context, in *pb.RestoreF
 		}
 	}
 
-	// Reload internal Storage
+	// Reload internal Storage, keeping the current master key setup, such as unseal
+	//  shares, the backup being sealed using the same master key. Keys are reloaded from
+	//  the restored key store, migrating backups taken prior to the key store layout.
+	masterKeyStorage := storage.Internal.MasterKey
+	storage.Internal.ClearKeys()
+	storage.Internal.KeyStoreVersion = 0
 	storage.Init()
+	storage.Internal.MasterKey = masterKeyStorage
+	storage.Internal.WriteToFile()
+	if err := loadKeyStore(); err != nil {
+		log.Printf("[rpc_restore_backup]: Failed to load restored key store: %v\n", err)
+		return backupEntry, fmt.Errorf("internal error")
+	}
 
 	return backupEntry, nil
 }
@@ -7,31 +7,50 @@ type BackupSubConfiguration struct {
 	BackupFrequency uint64 `json:"backupFrequency"` // How frequently to backup in Milliseconds
 }
 
+// Master Key Sub-config
+type MasterKeySubConfiguration struct {
+	KeyFile       string `json:"keyFile"`       // Path to the master key file, generated if missing on first start
+	PassphraseEnv string `json:"passphraseEnv"` // Environment variable holding the passphrase the master key is derived from, preferred over the key file
+}
+
 // Root Configuraiton Structure
 type Configuration struct {
-	DefaultKeyAlgorithm string                 `json:"defaultKeyAlgorithm"`
-	Insecure            bool                   `json:"insecure"`
-	GrpcPort            uint16                 `json:"grpcPort"`
-	GrpcHost            string                 `json:"grpcHost"`
-	TLSCertPath         string                 `json:"tlsCertPath"`
-	TLSKeyPath          string                 `json:"tlsKeyPath"`
-	Backup              BackupSubConfiguration `json:"backup"`
+	DefaultKeyAlgorithm string                    `json:"defaultKeyAlgorithm"`
+	DefaultRSAKeySize   int                       `json:"defaultRsaKeySize"` // RSA key size in bits (2048/3072/4096)
+	DefaultOAEPHash     string                    `json:"defaultOaepHash"`   // RSA-OAEP hash (sha256/sha384/sha512)
+	DefaultCipher       string                    `json:"defaultCipher"`     // File data cipher (aes-gcm/xchacha20poly1305)
+	Insecure            bool                      `json:"insecure"`
+	GrpcPort            uint16                    `json:"grpcPort"`
+	GrpcHost            string                    `json:"grpcHost"`
+	TLSCertPath         string                    `json:"tlsCertPath"`
+	TLSKeyPath          string                    `json:"tlsKeyPath"`
+	KeyScheduleInterval uint64                    `json:"keyScheduleInterval"` // How frequently keys are moved at their not-before & expiry times, rotated per their rotation policy, and counted encryptions saved, in Milliseconds
+	Backup              BackupSubConfiguration    `json:"backup"`
+	MasterKey           MasterKeySubConfiguration `json:"masterKey"`
 }
 
 // Assigned Default Values
 var (
 	LoadedConfig = Configuration{
 		DefaultKeyAlgorithm: "rsa",
+		DefaultRSAKeySize:   2048,
+		DefaultOAEPHash:     "sha256",
+		DefaultCipher:       "aes-gcm",
 		Insecure:            false,
 		GrpcHost:            "0.0.0.0",
 		GrpcPort:            50051,
 		TLSCertPath:         "cert/server-cert.pem",
 		TLSKeyPath:          "cert/server-key.pem",
+		KeyScheduleInterval: 60 * 1000, // Every minute by default
 		Backup: BackupSubConfiguration{
 			Enable:          true,
 			RetentionPeriod: 7 * 24 * 60 * 60 * 1000, // 7 Days by default
 			BackupFrequency: 7 * 24 * 60 * 60 * 1000, // Daily backups by default
 		},
+		MasterKey: MasterKeySubConfiguration{
+			KeyFile:       ".config/master.key",
+			PassphraseEnv: "OPENABYSS_MASTER_PASSPHRASE",
+		},
 	}
 	InternalConfigDirPath string = ".config"            // Directory that holds configs
 	ConfigFileName        string = "config-server.json" // Configuration JSON Filename
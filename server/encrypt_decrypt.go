@@ -1,16 +1,14 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
-	"io/ioutil"
+	"io"
 	"log"
 	"openabyss/entity"
 	pb "openabyss/proto/server"
@@ -19,19 +17,228 @@ import (
 	"os"
 	"path"
 	"regexp"
-	"time"
 )
 
-// Encrypts requested file, saving the location to an internal structure
-func (s openabyss_server) EncryptFile(ctx context.Context, in *pb.FilePacket) (*pb.EncryptResult, error) {
+// Resolved destination and key of a file being encrypted
+type encryptTarget struct {
+	fileName    string             // Client's file name
+	storagePath string             // Internal storage path the file is stored under
+	fileId      string             // Stored blob's name within the internal storage
+	blobPath    string             // Actual path to the stored blob
+	keyName     string             // Key used to encrypt
+	internalKey storage.KeyStorage // Stored key entry used to encrypt
+	compressed  bool               // File bytes are compressed
+	keyVersion  uint32             // Version of the key that encrypted the blob
+}
+
+// Resolved source and key of a file being decrypted
+type decryptSource struct {
+	fsFile      *storage.FileStorage // Internal storage entry of the file
+	blobPath    string               // Actual path to the stored blob
+	keyName     string               // Key used to decrypt
+	internalKey storage.KeyStorage   // Stored key entry used to decrypt
+	header      *entity.BlobHeader   // Header of the opened blob
+}
+
+// Whether the key's algorithm requires clients to sign their requests
+func keyRequiresSignature(internalKey storage.KeyStorage) bool {
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	return err == nil && algorithm.CanSign()
+}
+
+// Verifies given signing key's signature over given data
+func verifyKeySignature(internalKey storage.KeyStorage, data []byte, signature []byte) bool {
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	if err != nil {
+		return false
+	}
+	pk_pem, _ := base64.StdEncoding.DecodeString(internalKey.SigningPublicKey_pem)
+	return algorithm.Verify(pk_pem, data, signature)
+}
+
+// Returns the cipher algorithm the stored file was encrypted with
+func fileCipherAlgorithm(fsFile *storage.FileStorage) string {
+	// Files stored prior to tracking the cipher algorithm
+	if fsFile.CipherAlgorithm == "" {
+		return entity.CipherAES_CFB
+	}
+	return fsFile.CipherAlgorithm
+}
+
+// Key material of a key's version used to encrypt & decrypt files
+type keyMaterial struct {
+	internalKey storage.KeyStorage  // Stored key entry
+	version     storage.KeyVersion  // Version of the key's material
+	algorithm   entity.KeyAlgorithm // Algorithm of the key
+	material    *entity.KeyMaterial // Key material of the version
+}
+
+// Constructs the key material of the stored key's version, unsealing its private key
+//  material using the master key
+func versionKeyMaterial(keyName string, internalKey storage.KeyStorage, keyVersion storage.KeyVersion) (*entity.KeyMaterial, error) {
+	cipherEncKey, err := entity.UnsealString(keyVersion.CipherEncKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKeyPem, err := entity.UnsealString(keyVersion.PrivateKey_pem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.KeyMaterial{
+		StoreName:     entity.VersionKeyName(keyName, keyVersion.Version),
+		CipherEncKey:  cipherEncKey,
+		PrivateKeyPem: privateKeyPem,
+		PublicKeyPem:  keyVersion.PublicKey_pem,
+		KeySize:       internalKey.KeySize,
+		OAEPHash:      internalKey.OAEPHash,
+	}, nil
+}
+
+// Resolves the key material of the stored key's given version
+func loadKeyMaterial(keyName string, internalKey storage.KeyStorage, version uint32) (*keyMaterial, error) {
+	keyVersion := internalKey.GetVersion(version)
+	if keyVersion == nil {
+		log.Printf("Key '%s' version '%d' not found\n", keyName, version)
+		return nil, errors.New("key version not found")
+	}
+	switch keyVersion.State {
+	case storage.KeyVersion_Disabled:
+		return nil, errors.New("key version disabled")
+	case storage.KeyVersion_Destroyed:
+		return nil, errors.New("key version destroyed")
+	}
+
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	material, err := versionKeyMaterial(keyName, internalKey, *keyVersion)
+	if err != nil {
+		log.Printf("Failed infrastructure. Key '%s' version '%d' material could not be unsealed: %v\n", keyName, version, err)
+		return nil, errors.New("internal error")
+	}
+	if err := algorithm.Load(material); err != nil {
+		log.Printf("Failed infrastructure. Key '%s' version '%d' material invalid: %v\n", keyName, version, err)
+		return nil, errors.New("internal error")
+	}
+
+	return &keyMaterial{
+		internalKey: internalKey,
+		version:     *keyVersion,
+		algorithm:   algorithm,
+		material:    material,
+	}, nil
+}
+
+// Resolves the key material of the stored key's latest version, used to encrypt
+func loadLatestKeyMaterial(keyName string, internalKey storage.KeyStorage) (*keyMaterial, error) {
+	latestVersion := internalKey.LatestVersion()
+	if latestVersion == nil {
+		return nil, errors.New("key has no versions")
+	}
+	return loadKeyMaterial(keyName, internalKey, latestVersion.Version)
+}
+
+// Obtains the cipher key of the key based on the key's algorithm
+func (material *keyMaterial) cipherKey() ([]byte, error) {
+	return material.algorithm.CipherKey(material.material)
+}
+
+// Wraps given per-file data key based on the key's algorithm
+func (material *keyMaterial) wrapDataKey(dataKey []byte) ([]byte, error) {
+	return material.algorithm.WrapDataKey(material.material, dataKey)
+}
+
+// Unwraps given per-file data key based on the key's algorithm
+func (material *keyMaterial) unwrapDataKey(wrappedKey []byte) ([]byte, error) {
+	return material.algorithm.UnwrapDataKey(material.material, wrappedKey)
+}
+
+// Obtains the key of the blob's data, which is either the unwrapped data key, or
+//  the key's cipher key for blobs stored prior to per-file data keys
+func (material *keyMaterial) blobCipherKey(header *entity.BlobHeader) ([]byte, error) {
+	if len(header.WrappedKey) == 0 {
+		return material.cipherKey()
+	}
+	return material.unwrapDataKey(header.WrappedKey)
+}
+
+// Creates a writer that encrypts data into destWriter as a blob using a random
+//  data key, wrapped using the key material
+func newKeyEncryptWriter(material *keyMaterial, compressed bool, destWriter io.Writer) (io.WriteCloser, error) {
+	dataKey, err := entity.GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := material.wrapDataKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewBlobWriter(destWriter, dataKey, &entity.BlobHeader{
+		CipherAlgorithm: material.internalKey.CipherAlgorithm,
+		Compressed:      compressed,
+		KeyId:           material.internalKey.Uid,
+		KeyVersion:      material.version.Version,
+		WrappedKey:      wrappedKey,
+	})
+}
+
+// Creates a reader that decrypts the blob from srcReader using the material of the key's
+//  version that encrypted it, returning the blob's header. Legacy blobs are decrypted
+//  using the key's first version and the file's cipher algorithm.
+func newKeyDecryptReader(keyName string, internalKey storage.KeyStorage, fsFile *storage.FileStorage, srcReader io.Reader) (io.Reader, *entity.BlobHeader, error) {
+	bufReader := bufio.NewReader(srcReader)
+	header, err := entity.ReadBlobHeader(bufReader)
+	if err == entity.ErrLegacyBlob {
+		material, err := loadKeyMaterial(keyName, internalKey, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		cipherKey, err := material.cipherKey()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Legacy blobs have always been compressed by the client
+		header = &entity.BlobHeader{
+			CipherAlgorithm: fileCipherAlgorithm(fsFile),
+			Compressed:      true,
+			KeyVersion:      1,
+		}
+		reader, err := entity.NewCipherReader(bufReader, cipherKey, header.CipherAlgorithm)
+		return reader, header, err
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if header.KeyId != internalKey.Uid {
+		return nil, nil, errors.New("file was not encrypted with the given key")
+	}
+	material, err := loadKeyMaterial(keyName, internalKey, header.KeyVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobKey, err := material.blobCipherKey(header)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := entity.NewBlobReader(bufReader, blobKey, header)
+	return reader, header, err
+}
+
+// Validates the encryption request, resolving where and using which key the
+//  file will be stored
+func resolveEncryptTarget(fileName string, opts *pb.FileOptions) (*encryptTarget, error) {
 	// Verify Key provided
-	if len(in.Options.KeyName) == 0 {
+	if opts == nil || len(opts.KeyName) == 0 {
 		return nil, errors.New("no key name provided")
 	}
 
 	// Adjust root path
-	storagePath := regexp.MustCompile(`^(\.*)/`).ReplaceAllString(in.Options.StoragePath, "")
-	log.Printf("[EncryptFile]: storagePath extracted: '%s' - '%s'\n", in.Options.StoragePath, storagePath)
+	storagePath := regexp.MustCompile(`^(\.*)/`).ReplaceAllString(opts.StoragePath, "")
+	log.Printf("[EncryptFile]: storagePath extracted: '%s' - '%s'\n", opts.StoragePath, storagePath)
 
 	// Adjust for internal root path
 	if storagePath == "" {
@@ -39,10 +246,10 @@ func (s openabyss_server) EncryptFile(ctx context.Context, in *pb.FilePacket) (*
 	}
 
 	// Verify no duplicates
-	if !in.Options.Overwrite {
-		if _, err := storage.Internal.GetFileByPath(path.Join(storagePath, in.FileName)); err == nil {
-			log.Printf("[EncryptFile]: Duplicate internal FilePath found '%s'\n", path.Join(storagePath, in.FileName))
-			return &pb.EncryptResult{}, errors.New("duplicte internal file path'" + path.Join(storagePath, in.FileName) + "'")
+	if !opts.Overwrite {
+		if _, err := storage.Internal.GetFileByPath(path.Join(storagePath, fileName)); err == nil {
+			log.Printf("[EncryptFile]: Duplicate internal FilePath found '%s'\n", path.Join(storagePath, fileName))
+			return nil, errors.New("duplicte internal file path'" + path.Join(storagePath, fileName) + "'")
 		}
 	}
 
@@ -52,30 +259,16 @@ func (s openabyss_server) EncryptFile(ctx context.Context, in *pb.FilePacket) (*
 		os.Mkdir(storageDir, 0755)
 	}
 
-	// Check internal key found and validate signature
-	internalKey, ok := storage.Internal.KeyMap[in.Options.KeyName]
+	// Check internal key found
+	internalKey, ok := storage.Internal.GetKey(opts.KeyName)
 	if !ok {
-		log.Printf("[EncryptFile]: Key '%s' not found\n", in.FileName)
+		log.Printf("[EncryptFile]: Key '%s' not found\n", opts.KeyName)
 		return nil, errors.New("key id not found")
 	}
-	if internalKey.Algorithm == "ed25519" {
-		pk_pem, _ := base64.StdEncoding.DecodeString(internalKey.SigningPublicKey_pem)
-		pk := utils.PEM_to_ed25519(pk_pem)
-		if !ed25519.Verify(pk, in.FileBytes, in.FileSignature) {
-			log.Println("[EncryptFile]: File signature invalid")
-			return nil, errors.New("invalid signature")
-		}
-		log.Println("[EncryptFile]: File signature validated")
-	}
 
-	// Get requested encryption key
-	sk, key_store_found := entity.Store.Keys[in.Options.KeyName]
-
-	// Verify key has not expired (if expires | none zero)
-	expires_in := time.Now().UnixMilli() - int64(internalKey.ExpiresAt_UnixTimestamp)
-	if internalKey.ExpiresAt_UnixTimestamp != 0 && expires_in > 0 {
-		log.Printf("[EncryptFile]: Key '%s' expired '%d'ms ago\n", in.Options.KeyName, expires_in)
-		return nil, errors.New("failed to encrypt, key expired")
+	// Verify the key's status allows new encryptions, being active & not expired
+	if err := checkKeyState(opts.KeyName, internalKey, true); err != nil {
+		return nil, err
 	}
 
 	// Stored in internal storage for lookup
@@ -83,156 +276,233 @@ func (s openabyss_server) EncryptFile(ctx context.Context, in *pb.FilePacket) (*
 
 	// Generate fileId based on path
 	fileIdBuffer := sha256.Sum256([]byte(
-		path.Join(storedStoragePath, in.FileName),
+		path.Join(storedStoragePath, fileName),
 	))
 	fileId := hex.EncodeToString(fileIdBuffer[:])
 
-	// Encrypt the data
-	actualStoredPath := path.Join(storageDir, fileId)
-	log.Printf("[EncryptFile]: storing '%s' -> '%s'\n", path.Join(storagePath, in.FileName), actualStoredPath)
+	return &encryptTarget{
+		fileName:    fileName,
+		storagePath: storagePath,
+		fileId:      fileId,
+		blobPath:    path.Join(storageDir, fileId),
+		keyName:     opts.KeyName,
+		internalKey: internalKey,
+		compressed:  opts.Compressed,
+	}, nil
+}
 
-	// Write data to writer based on requested algorithm
-	destWriter, err := os.Create(actualStoredPath)
+// Encrypts the data written by writeFn into the target's blob. The blob is only
+//  moved into place once writeFn succeeds, leaving existing data untouched otherwise.
+func (target *encryptTarget) writeBlob(writeFn func(io.Writer) error) error {
+	log.Printf("[EncryptFile]: storing '%s' -> '%s'\n", path.Join(target.storagePath, target.fileName), target.blobPath)
+
+	partialBlobPath := target.blobPath + ".part"
+	destWriter, err := os.Create(partialBlobPath)
 	if err != nil {
 		utils.HandleErr(err, "[EncryptFile]: failed to create file path")
-		return nil, errors.New("internal storage failure")
-	}
-	switch internalKey.Algorithm {
-	case "rsa":
-		// Verify no monkey business and key was stored when the algorithm stored
-		//  is in fact RSA
-		if !key_store_found {
-			log.Println("[EncryptFile]: Failed infrastructure. Internal Key algorithm states 'rsa', but no key store to match")
-			return nil, errors.New("internal error")
-		}
+		return errors.New("internal storage failure")
+	}
 
-		// Encrypt the file by decrypting the cipher using rsa and
-		//  then encrypting the file
-		if err := entity.RSACipherEncrypt(in.FileBytes, destWriter, &sk, internalKey.CipherEncKey); err != nil {
-			utils.HandleErr(err, "[EncryptFile]: failed to encrypt")
-			destWriter.Close()
-			return nil, errors.New("internal error, failed to encrypt")
-		}
-	case "ed25519", "none":
-		// Encrypt internal file storage, even though the key itself is not
-		//  encrypted. That is due to trusting the autority OF storing said data.
-		var c cipher.Block
-		if cipherKey, err := base64.StdEncoding.DecodeString(internalKey.CipherEncKey); err != nil {
-			utils.HandleErr(err, "[EncryptFile]: failed to decode cipher")
-			return nil, errors.New("internal error")
-		} else {
-			if c, err = aes.NewCipher(cipherKey); err != nil {
-				utils.HandleErr(err, "[EncryptFile]: failed to create new cipher")
-				return nil, errors.New("internal error")
-			}
-		}
-		entity.CipherEncrypt(in.FileBytes, destWriter, c)
+	// Clean up partially written blob on failure
+	fail := func(err error) error {
+		destWriter.Close()
+		os.Remove(partialBlobPath)
+		return err
 	}
-	destWriter.Close()
 
-	// Store data in internal storage
-	if _, err := storage.Internal.Store(fileId, path.Join(storagePath, in.FileName), uint64(in.SizeInBytes), storage.Type_File, in.Options.Overwrite); err != nil {
+	// Write data to writer based on requested algorithm
+	material, err := loadLatestKeyMaterial(target.keyName, target.internalKey)
+	if err != nil {
+		return fail(err)
+	}
+	target.keyVersion = material.version.Version
+	encWriter, err := newKeyEncryptWriter(material, target.compressed, destWriter)
+	if err != nil {
+		utils.HandleErr(err, "[EncryptFile]: failed to create encryption writer")
+		return fail(errors.New("internal error, failed to encrypt"))
+	}
+	if err := writeFn(encWriter); err != nil {
+		return fail(err)
+	}
+	if err := encWriter.Close(); err != nil {
+		utils.HandleErr(err, "[EncryptFile]: failed to encrypt")
+		return fail(errors.New("internal error, failed to encrypt"))
+	}
+	if err := destWriter.Close(); err != nil {
+		utils.HandleErr(err, "[EncryptFile]: failed to write encrypted file")
+		return fail(errors.New("internal storage failure"))
+	}
+
+	if err := os.Rename(partialBlobPath, target.blobPath); err != nil {
+		utils.HandleErr(err, "[EncryptFile]: failed to move encrypted file into place")
+		os.Remove(partialBlobPath)
+		return errors.New("internal storage failure")
+	}
+	return nil
+}
+
+// Stores the encrypted target's data in internal storage
+func (target *encryptTarget) store(sizeInBytes int64, overwrite bool) (*pb.EncryptResult, error) {
+	filePath := path.Join(target.storagePath, target.fileName)
+	if fsFile, err := storage.Internal.Store(target.fileId, filePath, uint64(sizeInBytes), storage.Type_File, overwrite); err != nil {
 		log.Printf("[EncryptFile]: Failed to store encrypted file internally: %v\n", err)
 		return &pb.EncryptResult{}, errors.New("could not store data internally")
 	} else {
+		// Keep track of the cipher & key used to encrypt
+		fsFile.CipherAlgorithm = target.internalKey.CipherAlgorithm
+		fsFile.KeyUid = target.internalKey.Uid
+		fsFile.KeyVersion = target.keyVersion
+		storage.Internal.UpdateStorage(filePath, *fsFile)
+
 		storage.Internal.WriteToFile()
-		log.Printf("[EncryptFile]: Successfully stored encrypted data, %d bytes, internally\n", in.SizeInBytes)
+		countKeyVersionEncryption(target.keyName, target.keyVersion)
+		log.Printf("[EncryptFile]: Successfully stored encrypted data, %d bytes, internally\n", sizeInBytes)
 	}
 	return &pb.EncryptResult{
-		FileStoragePath: storagePath,
-		FileId:          fileId,
+		FileStoragePath: target.storagePath,
+		FileId:          target.fileId,
 	}, nil
-
 }
 
-// Encrypts requested file, saving the location to an internal structure
-func (s openabyss_server) DecryptFile(ctx context.Context, in *pb.DecryptRequest) (*pb.FilePacket, error) {
-	// Verify Key provided
-	if len(in.KeyName) == 0 {
-		return nil, errors.New("no key name provided")
+// Validates the decryption request, resolving the stored file and the key used
+//  to decrypt it. The key that encrypted the file is used if none is provided.
+func resolveDecryptSource(in *pb.DecryptRequest) (*decryptSource, error) {
+	// Adjust root path
+	storagePath := regexp.MustCompile(`^(\.*)`).ReplaceAllString(in.FilePath, "")
+	log.Printf("[DecryptFile]: storagePath extracted: '%s' -'%s'\n", in.FilePath, storagePath)
+
+	// Resolve key used to decrypt, prior to looking up the file if provided
+	keyName := string(in.KeyName)
+	internalKey, ok := storage.Internal.GetKey(keyName)
+	if len(keyName) > 0 && !ok {
+		log.Printf("[DecryptFile]: key '%s' not found\n", keyName)
+		return nil, errors.New("supplied key name not found")
+	}
+
+	// Obtain from internal storage
+	fsFile, err := storage.Internal.GetFileByPath(storagePath)
+	if err != nil {
+		log.Printf("[DecryptFile]: File '%s' not found: %v\n", in.FilePath, err)
+		return nil, errors.New("file '" + storagePath + "' not found")
+	}
+
+	// Use the key that encrypted the file
+	if len(keyName) == 0 {
+		if keyName, ok = storage.Internal.GetKeyNameByUid(fsFile.KeyUid); fsFile.KeyUid == "" || !ok {
+			log.Printf("[DecryptFile]: File '%s' key unknown\n", in.FilePath)
+			return nil, errors.New("no key name provided, key that encrypted the file is unknown")
+		}
+		internalKey, _ = storage.Internal.GetKey(keyName)
+		log.Printf("[DecryptFile]: Using recorded key '%s'\n", keyName)
+	}
+
+	// Verify the key's lifecycle state allows decryption
+	if err := checkKeyState(keyName, internalKey, false); err != nil {
+		return nil, err
 	}
 
 	// Check file signature prior to request completion
-	internalKey, ok := storage.Internal.KeyMap[string(in.KeyName)]
-	if !ok {
-		log.Printf("[DecryptFile]: key '%s' not found\n", in.KeyName)
-		return nil, errors.New("supplied key name not found")
+	if keyRequiresSignature(internalKey) {
+		if !verifyKeySignature(internalKey, []byte(in.FilePath), in.FilePathSignature) {
+			log.Println("[DecryptFile]: File signature invalid")
+			return nil, errors.New("invalid signature")
+		}
+		log.Println("[DecryptFile]: File signature validated")
+	}
+
+	return &decryptSource{
+		fsFile:      fsFile,
+		blobPath:    path.Join(storage.InternalStoragePath, fsFile.Name),
+		keyName:     keyName,
+		internalKey: internalKey,
+	}, nil
+}
+
+// Opens a reader to the decrypted blob of the source. The returned closer must be
+//  closed once done reading.
+func (source *decryptSource) openBlob() (io.Reader, io.Closer, error) {
+	blobFile, err := os.Open(source.blobPath)
+	if err != nil {
+		log.Printf("[DecryptFile]: Failed to read '%s'\n", source.blobPath)
+		return nil, nil, err
+	}
+
+	reader, header, err := newKeyDecryptReader(source.keyName, source.internalKey, source.fsFile, blobFile)
+	if err != nil {
+		log.Printf("[DecryptFile]: Failed to decrypt file '%s': %v\n", source.blobPath, err)
+		blobFile.Close()
+		return nil, nil, err
+	}
+	source.header = header
+	return reader, blobFile, nil
+}
+
+// Surfaces integrity failures to the client, hiding other internal failures
+func decryptError(err error) error {
+	if errors.Is(err, entity.ErrIntegrity) {
+		return err
+	}
+	return errors.New("internal failure, failed to decrypt")
+}
+
+// Encrypts requested file, saving the location to an internal structure
+func (s openabyss_server) EncryptFile(ctx context.Context, in *pb.FilePacket) (*pb.EncryptResult, error) {
+	target, err := resolveEncryptTarget(in.FileName, in.Options)
+	if err != nil {
+		return &pb.EncryptResult{}, err
 	}
-	if internalKey.Algorithm == "ed25519" {
-		pk_pem, _ := base64.StdEncoding.DecodeString(internalKey.SigningPublicKey_pem)
-		pk := utils.PEM_to_ed25519(pk_pem)
-		if !ed25519.Verify(pk, []byte(in.FilePath), in.FilePathSignature) {
+
+	// Validate signature
+	if keyRequiresSignature(target.internalKey) {
+		if !verifyKeySignature(target.internalKey, in.FileBytes, in.FileSignature) {
 			log.Println("[EncryptFile]: File signature invalid")
 			return nil, errors.New("invalid signature")
 		}
 		log.Println("[EncryptFile]: File signature validated")
 	}
 
-	// Get suplied entity based on name
-	sk, key_store_found := entity.Store.Keys[string(in.KeyName)]
+	// Encrypt the data
+	if err := target.writeBlob(func(w io.Writer) error {
+		_, err := w.Write(in.FileBytes)
+		return err
+	}); err != nil {
+		return nil, err
+	}
 
-	// Adjust root path
-	storagePath := regexp.MustCompile(`^(\.*)`).ReplaceAllString(in.FilePath, "")
-	log.Printf("[DecryptFile]: storagePath extracted: '%s' -'%s'\n", in.FilePath, storagePath)
+	// Store data in internal storage
+	return target.store(in.SizeInBytes, in.Options.Overwrite)
+}
 
-	// Obtain from internal storage
-	fsFile, err := storage.Internal.GetFileByPath(storagePath)
+// Decrypts requested file from internal storage
+func (s openabyss_server) DecryptFile(ctx context.Context, in *pb.DecryptRequest) (*pb.FilePacket, error) {
+	source, err := resolveDecryptSource(in)
 	if err != nil {
-		log.Printf("[DecryptFile]: File '%s' not found: %v\n", in.FilePath, err)
-		return &pb.FilePacket{}, errors.New("file '" + storagePath + "' not found")
+		return &pb.FilePacket{}, err
 	}
 
 	// Decrypt the data
-	encFilePath := path.Join(storage.InternalStoragePath, fsFile.Name)
-
-	if fsBytes, err := ioutil.ReadFile(encFilePath); err != nil {
-		log.Printf("[DecryptFile]: Failed to read '%s'\n", encFilePath)
+	reader, closer, err := source.openBlob()
+	if err != nil {
 		return nil, err
-	} else {
-		destWriter := bytes.NewBuffer(nil)
-
-		// Decrypt based on set Algorithm
-		switch internalKey.Algorithm {
-		case "rsa":
-			// Verify no monkey business and key was stored when the algorithm stored
-			//  is in fact RSA
-			if !key_store_found {
-				log.Println("[DecryptFile]: Failed infrastructure. Internal Key algorithm states 'rsa', but no key store to match")
-				return nil, errors.New("internal error")
-			}
-
-			// Encrypt the file by decrypting the cipher using rsa and
-			//  then encrypting the file
-			if err := entity.RSACipherDecrypt(fsBytes, destWriter, &sk, internalKey.CipherEncKey); err != nil {
-				log.Printf("[DecryptFile]: Failed to decrypt file '%s'\n", encFilePath)
-				return nil, errors.New("internal failure, failed to encrypt")
-			}
-			log.Printf("[DecryptFile]: Successfuly decrypted, %d bytes, file '%s'\n", fsFile.SizeInBytes, encFilePath)
-		case "ed25519", "none":
-			// Cipher was not encrypted but signature was verified, decrypt and
-			//  return the data from storage.
-			var c cipher.Block
-			if cipherKey, err := base64.StdEncoding.DecodeString(internalKey.CipherEncKey); err != nil {
-				utils.HandleErr(err, "[DecryptFile]: failed to decode cipher")
-				return nil, errors.New("internal error")
-			} else {
-				if c, err = aes.NewCipher(cipherKey); err != nil {
-					utils.HandleErr(err, "[DecryptFile]: failed to create new cipher")
-					return nil, errors.New("internal error")
-				}
-			}
-			entity.CipherDecrypt(fsBytes, destWriter, c)
-		}
+	}
+	defer closer.Close()
 
-		// Successful Response
-		return &pb.FilePacket{
-			FileBytes:   destWriter.Bytes(),
-			SizeInBytes: int64(fsFile.SizeInBytes),
-			FileName:    path.Base(fsFile.Path),
-			Options: &pb.FileOptions{
-				StoragePath: fsFile.Path,
-				KeyName:     string(in.KeyName),
-			},
-		}, nil
+	destWriter := bytes.NewBuffer(nil)
+	if _, err := io.Copy(destWriter, reader); err != nil {
+		log.Printf("[DecryptFile]: Failed to decrypt file '%s': %v\n", source.blobPath, err)
+		return nil, decryptError(err)
 	}
+	log.Printf("[DecryptFile]: Successfuly decrypted, %d bytes, file '%s'\n", source.fsFile.SizeInBytes, source.blobPath)
+
+	// Successful Response
+	return &pb.FilePacket{
+		FileBytes:   destWriter.Bytes(),
+		SizeInBytes: int64(source.fsFile.SizeInBytes),
+		FileName:    path.Base(source.fsFile.Path),
+		Options: &pb.FileOptions{
+			StoragePath: source.fsFile.Path,
+			KeyName:     source.keyName,
+			Compressed:  source.header.Compressed,
+		},
+	}, nil
 }
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"log"
+	pb "openabyss/proto/server"
+	"path"
+)
+
+// Encrypts streamed file chunks, writing them directly into internal storage
+func (s openabyss_server) EncryptFileStream(stream pb.OpenAbyss_EncryptFileStreamServer) error {
+	// First packet is expected to be the header
+	packet, err := stream.Recv()
+	if err != nil {
+		log.Printf("[EncryptFileStream]: Failed to receive header: %v\n", err)
+		return err
+	}
+	header := packet.GetHeader()
+	if header == nil {
+		log.Println("[EncryptFileStream]: Stream did not start with a header")
+		return errors.New("file stream header expected")
+	}
+
+	target, err := resolveEncryptTarget(header.FileName, header.Options)
+	if err != nil {
+		return err
+	}
+
+	// Stream chunks into the encrypted blob
+	totalBytes := 0
+	if err := target.writeBlob(func(w io.Writer) error {
+		var signature []byte
+		digest := sha256.New()
+
+		for {
+			packet, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				log.Printf("[EncryptFileStream]: Failed to receive chunk: %v\n", err)
+				return err
+			}
+
+			switch p := packet.Packet.(type) {
+			case *pb.FileStreamPacket_Chunk:
+				digest.Write(p.Chunk)
+				if _, err := w.Write(p.Chunk); err != nil {
+					log.Printf("[EncryptFileStream]: Failed to encrypt chunk: %v\n", err)
+					return errors.New("internal error, failed to encrypt")
+				}
+				totalBytes += len(p.Chunk)
+			case *pb.FileStreamPacket_FileSignature:
+				signature = p.FileSignature
+			default:
+				return errors.New("unexpected file stream packet")
+			}
+		}
+
+		// Validate signature over the streamed data's digest
+		if keyRequiresSignature(target.internalKey) {
+			if !verifyKeySignature(target.internalKey, digest.Sum(nil), signature) {
+				log.Println("[EncryptFileStream]: File signature invalid")
+				return errors.New("invalid signature")
+			}
+			log.Println("[EncryptFileStream]: File signature validated")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	log.Printf("[EncryptFileStream]: Encrypted %d streamed bytes\n", totalBytes)
+
+	// Store data in internal storage
+	result, err := target.store(header.SizeInBytes, header.Options.Overwrite)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(result)
+}
+
+// Decrypts requested file from internal storage, streaming it back in chunks
+func (s openabyss_server) DecryptFileStream(in *pb.DecryptRequest, stream pb.OpenAbyss_DecryptFileStreamServer) error {
+	source, err := resolveDecryptSource(in)
+	if err != nil {
+		return err
+	}
+
+	reader, closer, err := source.openBlob()
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	// Send header prior to file chunks
+	if err := stream.Send(&pb.FileStreamPacket{
+		Packet: &pb.FileStreamPacket_Header{
+			Header: &pb.FileStreamHeader{
+				SizeInBytes: int64(source.fsFile.SizeInBytes),
+				FileName:    path.Base(source.fsFile.Path),
+				Options: &pb.FileOptions{
+					StoragePath: source.fsFile.Path,
+					KeyName:     source.keyName,
+					Compressed:  source.header.Compressed,
+				},
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	// Stream decrypted chunks
+	chunk := make([]byte, fileChunkSize)
+	for {
+		n, err := io.ReadFull(reader, chunk)
+		if n > 0 {
+			if err := stream.Send(&pb.FileStreamPacket{
+				Packet: &pb.FileStreamPacket_Chunk{Chunk: chunk[:n]},
+			}); err != nil {
+				log.Printf("[DecryptFileStream]: Failed to send chunk: %v\n", err)
+				return err
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			log.Printf("[DecryptFileStream]: Failed to decrypt file '%s': %v\n", source.blobPath, err)
+			return decryptError(err)
+		}
+	}
+
+	log.Printf("[DecryptFileStream]: Successfuly decrypted, %d bytes, file '%s'\n", source.fsFile.SizeInBytes, source.blobPath)
+	return nil
+}
@@ -13,4 +13,6 @@ var (
 	tlsCert  = "cert/server.crt"
 	tlsKey   = "cert/server.key"
 	version  = "0.2.0"
+
+	fileChunkSize = 64 * 1024 // Size in bytes of streamed file chunks
 )
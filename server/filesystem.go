@@ -36,12 +36,15 @@ func (s openabyss_server) ListPathContents(ctx context.Context, in *pb.ListPathC
 
 		// Add all content to result
 		for _, sContent := range fsSubStorage.Storage {
+			keyName, _ := storage.Internal.GetKeyNameByUid(sContent.KeyUid)
 			internalStorage.Content = append(internalStorage.Content, &pb.ContentType{
 				Name:                  path.Base(sContent.Path),
 				Path:                  sContent.Path,
 				SizeInBytes:           sContent.SizeInBytes,
 				CreatedUnixTimestamp:  sContent.CreatedAt_UnixTimestamp,
 				ModifiedUnixTimestamp: sContent.ModifiedAt_UnixTimestamp,
+				KeyName:               keyName,
+				KeyVersion:            sContent.KeyVersion,
 			})
 		}
 	}
@@ -9,18 +9,30 @@ import (
 )
 
 func Init() {
-	// Load Entity Store
-	entity.Init()
+	// Load Configuration
+	configuration.Init()
 
 	// Load Storage
 	storage.Init()
 
-	// Load Configuration
-	configuration.Init()
+	// Unlock the master key, sealing key material at rest
+	if err := unlockMasterKey(); err != nil {
+		log.Fatalf("[server.init] Failed to unlock master key: %v\n", err)
+	}
+
+	// Load Entity Store, once unsealed if sealed
+	if !isSealed() {
+		if err := loadKeyStore(); err != nil {
+			log.Fatalf("[server.init] Failed to load key store: %v\n", err)
+		}
+	}
 
 	// Init Backup Manager
 	go storage.Init_Backup_Manager()
 
+	// Init Key Scheduler, moving keys at their not-before & expiry times
+	go initKeyScheduler()
+
 	// Setup internal configuraiton
 	port = configuration.LoadedConfig.GrpcPort
 	host = configuration.LoadedConfig.GrpcHost
@@ -34,3 +46,13 @@ func Init() {
 		insecure = true
 	}
 }
+
+// Loads the key store & migrates key material, requiring the master key
+func loadKeyStore() error {
+	if err := entity.Init(); err != nil {
+		return err
+	}
+	migrateFileKeyUids()
+	migrateSealKeyMaterial()
+	return nil
+}
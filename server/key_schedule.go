@@ -0,0 +1,114 @@
+package main
+
+import (
+	"log"
+	"openabyss/entity"
+	"openabyss/server/configuration"
+	"openabyss/server/storage"
+	"time"
+)
+
+// Moves keys into the status computed from their not-before & expiry times, saving
+//  each moved key. Returns the number of keys moved.
+func sweepKeyStates(now uint64) int {
+	moved := 0
+	for keyName := range storage.Internal.GetKeys() {
+		if sweepKeyState(keyName, now) {
+			moved += 1
+		}
+	}
+	return moved
+}
+
+// Moves the key into its computed status, re-reading the key once locked. Returns
+//  whether the key was moved.
+func sweepKeyState(keyName string, now uint64) bool {
+	unlockKey := lockKeys(keyName)
+	defer unlockKey()
+
+	entry, ok := storage.Internal.GetKey(keyName)
+	if !ok {
+		return false
+	}
+	status := entry.GetStatus(now)
+	if status == entry.GetState() {
+		return false
+	}
+
+	log.Printf("[key_scheduler]: Moving '%s' key state '%s' -> '%s'\n", keyName, entry.GetState(), status)
+	entry.SetState(status)
+	if err := entity.SaveKey(entry); err != nil {
+		log.Printf("[key_scheduler]: Failed to save '%s' key: %v\n", keyName, err)
+		return false
+	}
+	return true
+}
+
+// Rotates active keys whose rotation policy is due onto a new version, used to encrypt
+//  from then on. Stored files remain on their older version. Returns the number of keys
+//  rotated.
+func rotateDueKeys(now uint64) int {
+	rotated := 0
+	for keyName := range storage.Internal.GetKeys() {
+		if rotateDueKey(keyName, now) {
+			rotated += 1
+		}
+	}
+	return rotated
+}
+
+// Rotates the key if its rotation policy is due, re-reading the key once locked.
+//  Returns whether the key was rotated.
+func rotateDueKey(keyName string, now uint64) bool {
+	if !beginKeyRotation(keyName) {
+		return false
+	}
+	defer endKeyRotation(keyName)
+	unlockKey := lockKeys(keyName)
+	defer unlockKey()
+
+	entry, ok := storage.Internal.GetKey(keyName)
+	if !ok || entry.GetStatus(now) != storage.KeyState_Active || !entry.RotationDue(now) {
+		return false
+	}
+
+	latestVersion := entry.LatestVersion()
+	log.Printf("[key_scheduler]: Rotating '%s' key, version '%d' created on '%d' encrypted %d files\n", keyName, latestVersion.Version, latestVersion.CreatedAt_UnixTimestamp, latestVersion.Encryptions)
+	_, keyVersion, err := addKeyVersion(keyName, entry)
+	if err != nil {
+		log.Printf("[key_scheduler]: Failed to rotate '%s' key: %v\n", keyName, err)
+		return false
+	}
+	log.Printf("[key_scheduler]: Rotated '%s' key to version '%d'\n", keyName, keyVersion.Version)
+	return true
+}
+
+// Periodically moves keys at their not-before & expiry times, rotating keys whose
+//  rotation policy is due
+func initKeyScheduler() {
+	// Log key scheduler init stage
+	log.Println("[key_scheduler]: Initializing...")
+
+	lastSweep := int64(0)
+	for {
+		time.Sleep(time.Second)
+
+		// Sweep at set interval, keys being unavailable while sealed
+		time_now := time.Now().UnixMilli()
+		if time_now-lastSweep < int64(configuration.LoadedConfig.KeyScheduleInterval) {
+			continue
+		}
+		lastSweep = time_now
+
+		saveCountedEncryptions()
+		if isSealed() {
+			continue
+		}
+		if moved := sweepKeyStates(uint64(time_now)); moved > 0 {
+			log.Printf("[key_scheduler]: Moved %d keys\n", moved)
+		}
+		if rotated := rotateDueKeys(uint64(time_now)); rotated > 0 {
+			log.Printf("[key_scheduler]: Rotated %d keys\n", rotated)
+		}
+	}
+}
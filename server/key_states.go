@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/storage"
+	"time"
+)
+
+// Verifies the key's status allows encrypting new files, or decrypting stored files
+//  otherwise. The status is computed, not awaiting the key scheduler at boundaries.
+func checkKeyState(keyName string, internalKey storage.KeyStorage, encrypt bool) error {
+	switch internalKey.GetStatus(uint64(time.Now().UnixMilli())) {
+	case storage.KeyState_Active:
+		return nil
+	case storage.KeyState_DecryptOnly:
+		if !encrypt {
+			return nil
+		}
+		log.Printf("Key '%s' is decrypt-only, refusing to encrypt\n", keyName)
+		return errors.New("key is decrypt-only")
+	case storage.KeyState_Expired:
+		if !encrypt {
+			return nil
+		}
+		log.Printf("Key '%s' expired on '%d', refusing to encrypt\n", keyName, internalKey.ExpiresAt_UnixTimestamp)
+		return errors.New("failed to encrypt, key expired")
+	case storage.KeyState_Pending:
+		log.Printf("Key '%s' is not active before '%d'\n", keyName, internalKey.NotBefore_UnixTimestamp)
+		return errors.New("key not yet active")
+	case storage.KeyState_Disabled:
+		log.Printf("Key '%s' is disabled\n", keyName)
+		return errors.New("key disabled")
+	default:
+		log.Printf("Key '%s' is destroyed\n", keyName)
+		return errors.New("key destroyed")
+	}
+}
+
+// Constructs the key's state transitions response
+func keyStateTransitionsResponse(internalKey storage.KeyStorage) []*pb.EntityKeyStateTransition {
+	transitions := make([]*pb.EntityKeyStateTransition, len(internalKey.StateTransitions))
+	for idx, transition := range internalKey.StateTransitions {
+		transitions[idx] = &pb.EntityKeyStateTransition{
+			State:                transition.State,
+			ChangedUnixTimestamp: transition.ChangedAt_UnixTimestamp,
+		}
+	}
+	return transitions
+}
+
+// Constructs the key's entity response, without private key material
+func keyEntityResponse(keyName string, entry storage.KeyStorage) *pb.Entity {
+	return &pb.Entity{
+		Name:                   entry.Name,
+		Description:            entry.Description,
+		PublicKeyName:          keyPublicKeyPem(keyName, entry),
+		Algorithm:              entry.Algorithm,
+		CreatedUnixTimestamp:   entry.CreatedAt_UnixTimestamp,
+		ModifiedUnixTimestamp:  entry.ModifiedAt_UnixTimestamp,
+		ExpiresAtUnixTimestamp: entry.ExpiresAt_UnixTimestamp,
+		SigningPublicKeyPem:    entry.SigningPublicKey_pem,
+		Versions:               keyVersionsResponse(entry),
+		KeySize:                uint32(entry.KeySize),
+		OAEPHash:               entry.OAEPHash,
+		CipherAlgorithm:        entry.CipherAlgorithm,
+		Fingerprint:            keyFingerprint(keyName, entry),
+		State:                  entry.GetState(),
+		StateTransitions:       keyStateTransitionsResponse(entry),
+		NotBeforeUnixTimestamp: entry.NotBefore_UnixTimestamp,
+		Status:                 entry.GetStatus(uint64(time.Now().UnixMilli())),
+		RotationPolicy:         keyRotationPolicyResponse(entry),
+	}
+}
+
+// Moves the key into the given lifecycle state, saving it. Destroyed keys can't be
+//  moved out of their state, activated keys following their not-before & expiry times.
+func transitionKeyState(keyName string, state string) (*pb.Entity, error) {
+	unlockKey := lockKeys(keyName)
+	defer unlockKey()
+
+	entry, ok := storage.Internal.GetKey(keyName)
+	if !ok {
+		log.Printf("[transitionKeyState]: '%s' key not found\n", keyName)
+		return nil, errors.New("entity key-id not found")
+	}
+	if entry.GetState() == storage.KeyState_Destroyed {
+		return nil, errors.New("key destroyed")
+	}
+	if state == storage.KeyState_Active {
+		activated := storage.KeyStorage{
+			State:                   state,
+			NotBefore_UnixTimestamp: entry.NotBefore_UnixTimestamp,
+			ExpiresAt_UnixTimestamp: entry.ExpiresAt_UnixTimestamp,
+		}
+		state = activated.GetStatus(uint64(time.Now().UnixMilli()))
+	}
+	if entry.GetState() == state {
+		return keyEntityResponse(keyName, entry), nil
+	}
+
+	log.Printf("[transitionKeyState]: Moving '%s' key state '%s' -> '%s'\n", keyName, entry.GetState(), state)
+	entry.SetState(state)
+
+	var err error
+	if state == storage.KeyState_Destroyed {
+		// Remove the key material of every version, files encrypted with the key
+		//  become unrecoverable
+		versions := []uint32{}
+		for _, keyVersion := range entry.Versions {
+			versions = append(versions, keyVersion.Version)
+		}
+		if dependentFiles := storage.Internal.GetStorageByKeyUid(entry.Uid); len(dependentFiles) > 0 {
+			log.Printf("[transitionKeyState]: %d files encrypted with '%s' are unrecoverable\n", len(dependentFiles), keyName)
+		}
+		err = destroyKeyVersions(keyName, &entry, versions)
+	} else {
+		err = entity.SaveKey(entry)
+	}
+	if err != nil {
+		log.Printf("[transitionKeyState]: Failed to save '%s' key: %v\n", keyName, err)
+		return nil, errors.New("internal error")
+	}
+	return keyEntityResponse(keyName, entry), nil
+}
+
+// Enables the key, for decryption only if requested
+func (s openabyss_server) EnableKey(ctx context.Context, in *pb.KeyStateRequest) (*pb.Entity, error) {
+	if in.DecryptOnly {
+		return transitionKeyState(in.KeyId, storage.KeyState_DecryptOnly)
+	}
+	return transitionKeyState(in.KeyId, storage.KeyState_Active)
+}
+
+// Disables the key, refusing to encrypt or decrypt until re-enabled
+func (s openabyss_server) DisableKey(ctx context.Context, in *pb.KeyStateRequest) (*pb.Entity, error) {
+	return transitionKeyState(in.KeyId, storage.KeyState_Disabled)
+}
+
+// Destroys the key's material, keeping its metadata. Irreversible.
+func (s openabyss_server) DestroyKey(ctx context.Context, in *pb.KeyStateRequest) (*pb.Entity, error) {
+	return transitionKeyState(in.KeyId, storage.KeyState_Destroyed)
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/storage"
+	"sync"
+	"time"
+)
+
+// Generates the key material of the key's given version based on the key's algorithm
+//  & parameters, keeping only the parameters used by the algorithm. Keypairs stored as
+//  files are added to the key store under the version's key store name.
+func generateKeyVersion(keyName string, internalKey *storage.KeyStorage, version uint32) (storage.KeyVersion, error) {
+	keyVersion := storage.KeyVersion{
+		Version:                 version,
+		State:                   storage.KeyVersion_Enabled,
+		CreatedAt_UnixTimestamp: uint64(time.Now().UnixMilli()),
+	}
+
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	if err != nil {
+		return keyVersion, err
+	}
+	material := &entity.KeyMaterial{
+		StoreName: entity.VersionKeyName(keyName, version),
+		KeySize:   internalKey.KeySize,
+		OAEPHash:  internalKey.OAEPHash,
+	}
+	if err := algorithm.Generate(material); err != nil {
+		return keyVersion, err
+	}
+
+	internalKey.KeySize = material.KeySize
+	internalKey.OAEPHash = material.OAEPHash
+	keyVersion.CipherEncKey = material.CipherEncKey
+	keyVersion.PrivateKey_pem = material.PrivateKeyPem
+	keyVersion.PublicKey_pem = material.PublicKeyPem
+
+	// Private key material is only ever stored sealed using the master key
+	if err := sealKeyVersion(&keyVersion); err != nil {
+		return keyVersion, err
+	}
+	return keyVersion, nil
+}
+
+// Removes the key store entry & files of the key's version
+func removeKeyVersionFiles(keyName string, version uint32) {
+	entity.RemoveKeyPairFiles(entity.VersionKeyName(keyName, version))
+}
+
+// Keys with encryptions counted since they were last saved
+var (
+	countedKeysMutex sync.Mutex
+	countedKeys      = map[string]bool{}
+)
+
+// Destroys the key's given versions, removing their key material. The key is saved prior
+//  to removing the versions' keypair files, never referring to removed material. Files
+//  encrypted with the versions become unrecoverable.
+func destroyKeyVersions(keyName string, entry *storage.KeyStorage, versions []uint32) error {
+	// Modify a copy of the versions, the Key Store's entry sharing them until saved
+	entry.Versions = append([]storage.KeyVersion(nil), entry.Versions...)
+	for _, version := range versions {
+		if keyVersion := entry.GetVersion(version); keyVersion != nil {
+			keyVersion.State = storage.KeyVersion_Destroyed
+			keyVersion.CipherEncKey = ""
+			keyVersion.PrivateKey_pem = ""
+		}
+	}
+	if err := entity.SaveKey(*entry); err != nil {
+		return err
+	}
+	for _, version := range versions {
+		removeKeyVersionFiles(keyName, version)
+	}
+	return nil
+}
+
+// Counts a file encrypted using the key's version. Counted encryptions are checked
+//  against the key's rotation policy & saved in batches by the key scheduler.
+func countKeyVersionEncryption(keyName string, version uint32) {
+	unlockKey := lockKeys(keyName)
+	counted := storage.Internal.CountKeyVersionEncryption(keyName, version)
+	unlockKey()
+	if !counted {
+		return
+	}
+	countedKeysMutex.Lock()
+	countedKeys[keyName] = true
+	countedKeysMutex.Unlock()
+}
+
+// Saves keys with encryptions counted since they were last saved. Returns the number
+//  of keys saved.
+func saveCountedEncryptions() int {
+	countedKeysMutex.Lock()
+	keyNames := countedKeys
+	countedKeys = map[string]bool{}
+	countedKeysMutex.Unlock()
+
+	saved := 0
+	for keyName := range keyNames {
+		if saveCountedKey(keyName) {
+			saved += 1
+		}
+	}
+	return saved
+}
+
+// Saves the key's counted encryptions, re-reading the key once locked. Returns whether
+//  the key was saved.
+func saveCountedKey(keyName string) bool {
+	unlockKey := lockKeys(keyName)
+	defer unlockKey()
+
+	entry, ok := storage.Internal.GetKey(keyName)
+	if !ok {
+		return false
+	}
+	if err := entity.SaveKey(entry); err != nil {
+		log.Printf("[saveCountedEncryptions]: Failed to save '%s' key: %v\n", keyName, err)
+		return false
+	}
+	return true
+}
+
+// Encodes the public key of the key's latest version, if the key has one
+func keyPublicKeyPem(keyName string, internalKey storage.KeyStorage) []byte {
+	latestVersion := internalKey.LatestVersion()
+	if latestVersion == nil {
+		return nil
+	}
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	if err != nil {
+		return nil
+	}
+	material, err := versionKeyMaterial(keyName, internalKey, *latestVersion)
+	if err != nil {
+		return nil
+	}
+	return algorithm.PublicKeyPem(material)
+}
+
+// Constructs the key's versions response
+func keyVersionsResponse(internalKey storage.KeyStorage) []*pb.EntityKeyVersion {
+	versions := make([]*pb.EntityKeyVersion, len(internalKey.Versions))
+	for idx, keyVersion := range internalKey.Versions {
+		versions[idx] = &pb.EntityKeyVersion{
+			Version:              keyVersion.Version,
+			State:                keyVersion.State,
+			CreatedUnixTimestamp: keyVersion.CreatedAt_UnixTimestamp,
+			Encryptions:          keyVersion.Encryptions,
+		}
+	}
+	return versions
+}
+
+// Enables, disables or destroys a key's version. The latest version is used to encrypt,
+//  requiring the key to be rotated prior to disabling or destroying it.
+func (s openabyss_server) ModifyKeyVersion(ctx context.Context, in *pb.KeyVersionModifyRequest) (*pb.Entity, error) {
+	unlockKey := lockKeys(in.KeyId)
+	defer unlockKey()
+
+	entry, ok := storage.Internal.GetKey(in.KeyId)
+	if !ok {
+		log.Printf("[ModifyKeyVersion]: '%s' key not found\n", in.KeyId)
+		return nil, errors.New("entity key-id not found")
+	}
+
+	if entry.GetState() == storage.KeyState_Destroyed {
+		return nil, errors.New("key destroyed")
+	}
+
+	keyVersion := entry.GetVersion(in.Version)
+	if keyVersion == nil {
+		log.Printf("[ModifyKeyVersion]: '%s' key version '%d' not found\n", in.KeyId, in.Version)
+		return nil, errors.New("key version not found")
+	}
+	if keyVersion.State == storage.KeyVersion_Destroyed {
+		return nil, errors.New("key version destroyed")
+	}
+	if in.Version == entry.LatestVersion().Version && in.State != storage.KeyVersion_Enabled {
+		return nil, errors.New("latest key version is used to encrypt, rotate the key prior to modifying it")
+	}
+
+	log.Printf("[ModifyKeyVersion]: Modifying '%s' key version '%d' state '%s' -> '%s'\n", in.KeyId, in.Version, keyVersion.State, in.State)
+	entry.ModifiedAt_UnixTimestamp = uint64(time.Now().UnixMilli())
+
+	var err error
+	switch in.State {
+	case storage.KeyVersion_Enabled, storage.KeyVersion_Disabled:
+		// Modify a copy of the versions, the Key Store's entry sharing them until saved
+		entry.Versions = append([]storage.KeyVersion(nil), entry.Versions...)
+		entry.GetVersion(in.Version).State = in.State
+		err = entity.SaveKey(entry)
+	case storage.KeyVersion_Destroyed:
+		err = destroyKeyVersions(in.KeyId, &entry, []uint32{in.Version})
+	default:
+		return nil, errors.New("key version state not supported")
+	}
+	if err != nil {
+		log.Printf("[ModifyKeyVersion]: Failed to save '%s' key: %v\n", in.KeyId, err)
+		return nil, errors.New("internal error")
+	}
+
+	return keyEntityResponse(in.KeyId, entry), nil
+}
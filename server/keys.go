@@ -1,35 +1,88 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"crypto/ed25519"
-	"crypto/rand"
-	"crypto/x509"
 	"encoding/base64"
-	"encoding/pem"
 	"errors"
 	"log"
 	"openabyss/entity"
 	pb "openabyss/proto/server"
+	"openabyss/server/configuration"
 	"openabyss/server/storage"
 	"openabyss/utils"
 	"os"
-	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
+// Locks of keys being modified, serializing modifications of a key between RPCs & the
+//  key scheduler. Locks are removed once no longer held or awaited.
+type keyLock struct {
+	mutex   sync.Mutex
+	holders int
+}
+
+var (
+	keyLocksMutex sync.Mutex
+	keyLocks      = map[string]*keyLock{}
+)
+
+// Locks the given keys for modification, in name order so that keys locked together
+//  never deadlock. Keys must be re-read once locked. Returns the function unlocking them.
+func lockKeys(keyNames ...string) func() {
+	sortedNames := []string{}
+	seenNames := map[string]bool{}
+	for _, keyName := range keyNames {
+		if !seenNames[keyName] {
+			seenNames[keyName] = true
+			sortedNames = append(sortedNames, keyName)
+		}
+	}
+	sort.Strings(sortedNames)
+
+	locks := []*keyLock{}
+	for _, keyName := range sortedNames {
+		keyLocksMutex.Lock()
+		lock, ok := keyLocks[keyName]
+		if !ok {
+			lock = &keyLock{}
+			keyLocks[keyName] = lock
+		}
+		lock.holders += 1
+		keyLocksMutex.Unlock()
+
+		lock.mutex.Lock()
+		locks = append(locks, lock)
+	}
+
+	return func() {
+		for idx := len(locks) - 1; idx >= 0; idx-- {
+			locks[idx].mutex.Unlock()
+		}
+		keyLocksMutex.Lock()
+		defer keyLocksMutex.Unlock()
+		for idx, keyName := range sortedNames {
+			locks[idx].holders -= 1
+			if locks[idx].holders == 0 {
+				delete(keyLocks, keyName)
+			}
+		}
+	}
+}
+
 // Obtains available stored Entity Keys
 func (s openabyss_server) GetKeyNames(ctx context.Context, in *pb.EmptyMessage) (*pb.GetKeyNamesResponse, error) {
-	log.Printf("[GetKeyNames]: Total Entities in Store: %d\n", len(storage.Internal.KeyMap))
+	keyMap := storage.Internal.GetKeys()
+	log.Printf("[GetKeyNames]: Total Entities in Store: %d\n", len(keyMap))
 
 	keyResp := &pb.GetKeyNamesResponse{
-		Keys: make([]string, len(storage.Internal.KeyMap)),
+		Keys: make([]string, len(keyMap)),
 	}
 
 	idx := 0
-	for _, v := range storage.Internal.KeyMap {
+	for _, v := range keyMap {
 		keyResp.Keys[idx] = v.Name
 		idx += 1
 	}
@@ -39,62 +92,81 @@ func (s openabyss_server) GetKeyNames(ctx context.Context, in *pb.EmptyMessage)
 
 // Obtains available stored Entities without the Private Keys
 func (s openabyss_server) GetKeys(ctx context.Context, in *pb.EmptyMessage) (*pb.GetKeysResponse, error) {
-	log.Printf("[GetKeys]: Total Entities in Store: %d\n", len(storage.Internal.KeyMap))
+	keyMap := storage.Internal.GetKeys()
+	log.Printf("[GetKeys]: Total Entities in Store: %d\n", len(keyMap))
 
 	respObj := &pb.GetKeysResponse{
-		Entities: make([]*pb.Entity, len(storage.Internal.KeyMap)),
+		Entities: make([]*pb.Entity, len(keyMap)),
 	}
 
 	idx := 0
-	for key, value := range storage.Internal.KeyMap {
-		// Encode Public Key
-		publicKeyBuffer := bytes.NewBuffer(nil)
-		if entity.Store.Has(key) {
-			v := entity.Store.Get(key)
-			pem.Encode(publicKeyBuffer, &pem.Block{
-				Type:  "RSA PUBLIC KEY",
-				Bytes: x509.MarshalPKCS1PublicKey(v.PublicKey),
-			})
-		}
-
-		// Construct response for the entry
-		respObj.Entities[idx] = &pb.Entity{
-			Name:                   value.Name,
-			PublicKeyName:          publicKeyBuffer.Bytes(),
-			Description:            value.Description,
-			Algorithm:              value.Algorithm,
-			CreatedUnixTimestamp:   value.CreatedAt_UnixTimestamp,
-			ModifiedUnixTimestamp:  value.ModifiedAt_UnixTimestamp,
-			ExpiresAtUnixTimestamp: value.ExpiresAt_UnixTimestamp,
-			SigningPublicKeyPem:    value.SigningPublicKey_pem,
-		}
+	for key, value := range keyMap {
+		respObj.Entities[idx] = keyEntityResponse(key, value)
 		idx += 1
 	}
 
 	return respObj, nil
 }
 
-// Generates AEK Key used for Cipher block
-func GenerateAESKey() []byte {
-	// Generate a random 32-bit AES Key to use for Encrypting & Decrypting Data
-	aesKey := make([]byte, 32)
-	rand.Reader.Read(aesKey)
-	return aesKey
+// Obtains the key algorithms supported by the server
+func (s openabyss_server) GetKeyAlgorithms(ctx context.Context, in *pb.EmptyMessage) (*pb.KeyAlgorithmsResponse, error) {
+	resp := &pb.KeyAlgorithmsResponse{
+		Algorithms: []*pb.KeyAlgorithm{},
+	}
+	for _, algorithm := range entity.KeyAlgorithms() {
+		resp.Algorithms = append(resp.Algorithms, &pb.KeyAlgorithm{
+			Name:        algorithm.Name(),
+			Description: algorithm.Description(),
+			Signing:     algorithm.CanSign(),
+		})
+	}
+	for _, algorithm := range entity.CipherAlgorithms() {
+		if algorithm.Authenticated() {
+			resp.Ciphers = append(resp.Ciphers, &pb.CipherAlgorithm{
+				Name:        algorithm.Name(),
+				Description: algorithm.Description(),
+			})
+		}
+	}
+	return resp, nil
 }
 
 // Generate a keypair given a unique key name
 func (s openabyss_server) GenerateKeyPair(ctx context.Context, in *pb.GenerateEntityRequest) (*pb.Entity, error) {
+	unlockKey := lockKeys(in.Name)
+	defer unlockKey()
+
 	// Early return: Keypair name already exists
-	if _, ok := storage.Internal.KeyMap[in.Name]; ok {
+	if _, ok := storage.Internal.GetKey(in.Name); ok {
 		log.Printf("[GenerateKeyPair]: Could not generate. KeyPair '%s' already exists\n", in.Name)
 		return nil, errors.New("keypair name already exists")
 	}
+	if err := entity.ValidateKeyName(in.Name); err != nil {
+		return nil, err
+	}
+
+	// Use server-wide defaults unless requested otherwise
+	if in.Algorithm == "" {
+		in.Algorithm = configuration.LoadedConfig.DefaultKeyAlgorithm
+	}
+	if in.KeySize == 0 {
+		in.KeySize = uint32(configuration.LoadedConfig.DefaultRSAKeySize)
+	}
+	if in.OAEPHash == "" {
+		in.OAEPHash = configuration.LoadedConfig.DefaultOAEPHash
+	}
+	if in.CipherAlgorithm == "" {
+		in.CipherAlgorithm = configuration.LoadedConfig.DefaultCipher
+	}
+	if _, err := entity.GetSelectableCipherAlgorithm(in.CipherAlgorithm); err != nil {
+		log.Printf("[GenerateKeyPair]: Cipher '%s' not selectable: %v\n", in.CipherAlgorithm, err)
+		return nil, err
+	}
 
 	// Generate requested key by algorithm
 	log.Printf("[GenerateKeyPair]: Generating KeyPair[%s] for '%s' key\n", in.Algorithm, in.Name)
 
 	// Shared data between algorithms
-	aesKey := GenerateAESKey()
 	keyExpiresAt := uint64(time.Now().UnixMilli()) + in.ExpiresInUnixTimestamp
 	if in.ExpiresInUnixTimestamp == 0 {
 		keyExpiresAt = 0
@@ -102,74 +174,57 @@ func (s openabyss_server) GenerateKeyPair(ctx context.Context, in *pb.GenerateEn
 
 	// Construct Key Entries with pre-set shared values
 	keyStorage := storage.KeyStorage{
+		Uid:                      storage.GenerateKeyUid(),
 		Name:                     in.Name,
 		Description:              in.Description,
 		Algorithm:                in.Algorithm,
-		CipherAlgorithm:          "aes", // NOTE: Move to specific entry unless shared
-		CipherEncKey:             base64.StdEncoding.EncodeToString(aesKey),
+		CipherAlgorithm:          in.CipherAlgorithm,
 		CreatedAt_UnixTimestamp:  uint64(time.Now().UnixMilli()),
 		ModifiedAt_UnixTimestamp: uint64(time.Now().UnixMilli()),
 		ExpiresAt_UnixTimestamp:  uint64(keyExpiresAt),
-	}
-	response := &pb.Entity{
-		Name:                   in.Name,
-		Description:            in.Description,
-		Algorithm:              in.Algorithm,
-		CreatedUnixTimestamp:   uint64(time.Now().UnixMilli()),
-		ModifiedUnixTimestamp:  uint64(time.Now().UnixMilli()),
-		ExpiresAtUnixTimestamp: uint64(time.Now().UnixMilli()) + in.ExpiresInUnixTimestamp,
+		NotBefore_UnixTimestamp:  in.NotBeforeUnixTimestamp,
+		RotationPolicy:           keyRotationPolicy(in.RotationPolicy),
 	}
 
 	// Generate key based on given Algorithm
-	switch in.Algorithm {
-	case "rsa":
-		e1, err := entity.GenerateKeys(entity.KeyStorePath, in.Name, 2048, aesKey)
-		if err == nil {
-			log.Println("Generated Key:", e1.Name)
-
-			// Modify entries to represent RSA algorithm option
-			keyStorage.Name = e1.Name
-
-			// Encrypt the AES Key
-			encryptedAesKey := bytes.NewBufferString("")
-			if err = entity.Encrypt(aesKey, encryptedAesKey, e1.PrivateKey); err != nil {
-				utils.HandleErr(err, "[GenerateKeyPair]: Failed to encrypt aes key")
-				return nil, errors.New("internal error")
-			}
-
-			// Override Cipher Key with base64 encrypted aes key
-			keyStorage.CipherEncKey = base64.StdEncoding.EncodeToString(encryptedAesKey.Bytes())
-
-			entity.Store.Add(e1)
-			storage.Internal.KeyMap[e1.Name] = keyStorage
-
-			response.Name = e1.Name
-			response.PublicKeyName = x509.MarshalPKCS1PublicKey(e1.PublicKey)
-		} else {
-			log.Printf("[GenerateKeyPair]: Could not generate KeyPair[%s] for '%s' key\n", in.Algorithm, in.Name)
-			return nil, err
-		}
-	case "ed25519": // Signature
-		// Generate Public/Private Sig keys | Convert to base64 and store them
-		//  respectively. Private key goes to user, public key goes to both
-		if pk, sk, err := ed25519.GenerateKey(rand.Reader); err != nil {
+	algorithm, err := entity.GetKeyAlgorithm(in.Algorithm)
+	if err != nil {
+		log.Printf("[GenerateKeyPair]: Algorithm '%s' not supported\n", in.Algorithm)
+		return nil, errors.New("algorithm not supported")
+	}
+	keyStorage.KeySize = int(in.KeySize)
+	keyStorage.OAEPHash = in.OAEPHash
+	keyVersion, err := generateKeyVersion(in.Name, &keyStorage, 1)
+	if err != nil {
+		log.Printf("[GenerateKeyPair]: Could not generate KeyPair[%s] for '%s' key: %v\n", in.Algorithm, in.Name, err)
+		return nil, err
+	}
+	keyStorage.Versions = []storage.KeyVersion{keyVersion}
+	keyStorage.SetState(keyStorage.GetStatus(uint64(time.Now().UnixMilli())))
+	log.Println("Generated Key:", entity.VersionKeyName(in.Name, keyVersion.Version))
+
+	// Generate Public/Private Sig keys | Convert to base64 and store them
+	//  respectively. Private key goes to user, public key goes to both
+	signingPrivateKeySeed := ""
+	if algorithm.CanSign() {
+		signingKey, err := algorithm.GenerateSigningKey()
+		if err != nil {
 			log.Println("[GenerateKeyPair]: Failed to generate signing algorithm key")
 			return nil, errors.New("internal error: failed to genreate signing keys")
-		} else {
-			pk_pem := utils.ED25519_to_pem(pk)
-			keyStorage.SigningPublicKey_pem = base64.StdEncoding.EncodeToString(pk_pem)
-			response.SigningPrivateKeySeed = base64.StdEncoding.EncodeToString(sk.Seed())
-			response.SigningPublicKeyPem = keyStorage.SigningPublicKey_pem
 		}
+		keyStorage.SigningPublicKey_pem = base64.StdEncoding.EncodeToString(signingKey.PublicKeyPem)
+		signingPrivateKeySeed = base64.StdEncoding.EncodeToString(signingKey.PrivateKeySeed)
+	}
 
-		// Modify & Add Key to store
-		storage.Internal.KeyMap[in.Name] = keyStorage
-	case "none": // No Encryption | AES-Only
-	default:
-		log.Printf("[GenerateKeyPair]: Algorithm '%s' not supported\n", in.Algorithm)
-		return nil, errors.New("algorithm not supported")
+	// Add Key to store
+	if err := entity.SaveKey(keyStorage); err != nil {
+		log.Printf("[GenerateKeyPair]: Failed to save '%s' key: %v\n", in.Name, err)
+		removeKeyVersionFiles(in.Name, keyVersion.Version)
+		return nil, errors.New("internal error")
 	}
 
+	response := keyEntityResponse(in.Name, keyStorage)
+	response.SigningPrivateKeySeed = signingPrivateKeySeed
 	return response, nil
 }
 
@@ -178,18 +233,25 @@ func (s openabyss_server) ModifyKeyPair(ctx context.Context, in *pb.EntityModify
 	// Trim spaces
 	newName := strings.Trim(in.Name, " ")
 	newDesc := strings.Trim(in.Description, " ")
+	unlockKeys := lockKeys(in.KeyId, newName)
+	defer unlockKeys()
 
 	// Get entry to be modified
-	if entry, ok := storage.Internal.KeyMap[in.KeyId]; !ok {
+	if entry, ok := storage.Internal.GetKey(in.KeyId); !ok {
 		log.Printf("[ModifyKeyPair]: '%s' key not found\n", in.KeyId)
 		return nil, errors.New("entity key-id not found")
 	} else {
 		log.Printf("[ModifyKeyPair]: Modifying '%s' key\n", in.KeyId)
 
 		// Verify no Duplicates
-		if _, ok := storage.Internal.KeyMap[newName]; len(newName) > 0 && ok {
+		if _, ok := storage.Internal.GetKey(newName); len(newName) > 0 && ok {
 			return nil, errors.New("new name for key already exists")
 		}
+		if len(newName) > 0 {
+			if err := entity.ValidateKeyName(newName); err != nil {
+				return nil, err
+			}
+		}
 
 		// Start modifying
 		if len(newName) > 0 {
@@ -206,35 +268,40 @@ func (s openabyss_server) ModifyKeyPair(ctx context.Context, in *pb.EntityModify
 
 		// Modify entity expiration
 		if in.ModifyKeyExpiration {
-			log.Printf("[ModifyKeyPair]: Modifying expiration from '%d' -> '%d' for key '%s'\n", in.ExpiresInUnixTimestamp, entry.ExpiresAt_UnixTimestamp, in.KeyId)
-
 			// Construct Key Expiration
 			keyExpiresAt := uint64(time.Now().UnixMilli()) + in.ExpiresInUnixTimestamp
 			if in.ExpiresInUnixTimestamp == 0 {
 				keyExpiresAt = 0
 			}
+			log.Printf("[ModifyKeyPair]: Modifying expiration from '%d' -> '%d' for key '%s'\n", entry.ExpiresAt_UnixTimestamp, keyExpiresAt, in.KeyId)
 			entry.ExpiresAt_UnixTimestamp = keyExpiresAt
 		}
 
-		// Modify Key name & old map entries
+		// Modify entity activation, the key scheduler moving the key at its new boundaries
+		if in.ModifyKeyNotBefore {
+			log.Printf("[ModifyKeyPair]: Modifying not-before from '%d' -> '%d' for key '%s'\n", entry.NotBefore_UnixTimestamp, in.NotBeforeUnixTimestamp, in.KeyId)
+			entry.NotBefore_UnixTimestamp = in.NotBeforeUnixTimestamp
+		}
+
+		// Modify entity rotation policy, replacing the previous policy
+		if in.ModifyRotationPolicy {
+			log.Printf("[ModifyKeyPair]: Modifying rotation policy to every '%d'ms, after '%d' encryptions for key '%s'\n", in.RotationPolicy.GetEveryUnixTimestamp(), in.RotationPolicy.GetAfterEncryptions(), in.KeyId)
+			entry.RotationPolicy = keyRotationPolicy(in.RotationPolicy)
+		}
+
+		// Move the key's directory & Key Store entries prior to storing new metadata
 		if len(newName) > 0 && in.KeyId != newName {
-			// Store internally with new key
-			storage.Internal.KeyMap[newName] = entry
-
-			// Modify Entity Key Store
-			newStoreKey := entity.Store.Keys[in.KeyId]
-			newStoreKey.Name = newName
-			entity.Store.Keys[newName] = newStoreKey
-			os.Rename(path.Join(entity.KeyStorePath, in.KeyId), path.Join(entity.KeyStorePath, newName))
-			os.Rename(path.Join(entity.KeyStorePath, in.KeyId+".pub"), path.Join(entity.KeyStorePath, newName+".pub"))
-
-			// Remove old Keys
-			delete(entity.Store.Keys, in.KeyId)
-			delete(storage.Internal.KeyMap, in.KeyId)
-		} else { // Store new metadata
-			storage.Internal.KeyMap[in.KeyId] = entry
+			if err := entity.RenameKey(in.KeyId, newName); err != nil {
+				log.Printf("[ModifyKeyPair]: Failed to rename '%s' key: %v\n", in.KeyId, err)
+				return nil, errors.New("failed to rename key")
+			}
+		} else {
 			newName = in.KeyId
 		}
+		if err := entity.SaveKey(entry); err != nil {
+			log.Printf("[ModifyKeyPair]: Failed to save '%s' key: %v\n", newName, err)
+			return nil, errors.New("internal error")
+		}
 	}
 
 	// No new name change,
@@ -242,65 +309,97 @@ func (s openabyss_server) ModifyKeyPair(ctx context.Context, in *pb.EntityModify
 		newName = in.KeyId
 	}
 
-	// Generate Public Key Buffer
-	v := entity.Store.Keys[newName]
-	publicKeyBuffer := bytes.NewBuffer(nil)
-	pem.Encode(publicKeyBuffer, &pem.Block{
-		Type:  "RSA PUBLIC KEY",
-		Bytes: x509.MarshalPKCS1PublicKey(v.PublicKey),
-	})
-
-	entity := storage.Internal.KeyMap[newName]
-	return &pb.Entity{
-		Name:                   entity.Name,
-		Description:            entity.Description,
-		PublicKeyName:          publicKeyBuffer.Bytes(),
-		Algorithm:              entity.Algorithm,
-		CreatedUnixTimestamp:   entity.CreatedAt_UnixTimestamp,
-		ModifiedUnixTimestamp:  entity.ModifiedAt_UnixTimestamp,
-		ExpiresAtUnixTimestamp: entity.ExpiresAt_UnixTimestamp,
-	}, nil
+	entry, _ := storage.Internal.GetKey(newName)
+	return keyEntityResponse(newName, entry), nil
+}
+
+// Key removal modes, handling stored files encrypted with the key
+const (
+	KeyRemoveMode_Refuse  = ""        // Refuse removal while files depend on the key
+	KeyRemoveMode_Cascade = "cascade" // Remove dependent files along with the key
+	KeyRemoveMode_Orphan  = "orphan"  // Keep dependent files, which become unrecoverable
+)
+
+// Returns the paths of stored files encrypted with the key, including files stored
+//  prior to recording their key that may have been
+func keyDependentPaths(keyName string, entry storage.KeyStorage) []string {
+	dependentPaths := []string{}
+	for _, fsFile := range storage.Internal.GetAllStorage() {
+		if fileMayUseKey(keyName, entry, &fsFile) {
+			dependentPaths = append(dependentPaths, fsFile.Path)
+		}
+	}
+	return dependentPaths
+}
+
+// Removes the stored files at given paths, along with their internal storage entries.
+//  Returns the paths removed.
+func removeDependentFiles(dependentPaths []string) []string {
+	removedPaths := []string{}
+	for _, filePath := range dependentPaths {
+		internalFilepath, err := storage.Internal.RemoveStorage(filePath)
+		if err != nil {
+			log.Printf("[removeDependentFiles]: failed to remove internal storage '%s'\n", filePath)
+			continue
+		}
+		if err := os.Remove(internalFilepath); err != nil {
+			log.Printf("[removeDependentFiles]: failed to remove actual file '%s'\n", internalFilepath)
+		}
+		removedPaths = append(removedPaths, filePath)
+	}
+	return removedPaths
 }
 
-// Remove existing keypair
-func (s openabyss_server) RemoveKeyPair(ctx context.Context, in *pb.EntityRemoveRequest) (*pb.Entity, error) {
+// Remove existing keypair. Removal is refused while stored files depend on the key,
+//  unless dependent files are requested to be removed or orphaned.
+func (s openabyss_server) RemoveKeyPair(ctx context.Context, in *pb.EntityRemoveRequest) (*pb.EntityRemoveResponse, error) {
+	unlockKey := lockKeys(in.KeyId)
+	defer unlockKey()
 
 	// Get entry to be removed
-	if entry, ok := storage.Internal.KeyMap[in.KeyId]; !ok {
+	if entry, ok := storage.Internal.GetKey(in.KeyId); !ok {
 		log.Printf("[RemoveKeyPair]: Key '%s' not found\n", in.KeyId)
 		return nil, errors.New("key-id not found")
 	} else {
-		log.Printf("[RemoveKeyPair]: Removing '%s' key\n", in.KeyId)
-
-		// Generate Public Key Buffer (RSA) and then remove rsa key entry data
-		publicKeyBuffer := bytes.NewBufferString("")
-		if entry.Algorithm == "rsa" {
-			v := entity.Store.Keys[in.KeyId]
-			publicKeyBuffer = bytes.NewBuffer(nil)
-			pem.Encode(publicKeyBuffer, &pem.Block{
-				Type:  "RSA PUBLIC KEY",
-				Bytes: x509.MarshalPKCS1PublicKey(v.PublicKey),
-			})
+		if in.Mode != KeyRemoveMode_Refuse && in.Mode != KeyRemoveMode_Cascade && in.Mode != KeyRemoveMode_Orphan {
+			return nil, errors.New("key removal mode not supported")
+		}
+
+		// Find stored files encrypted with the key
+		resp := &pb.EntityRemoveResponse{
+			DependentPaths: keyDependentPaths(in.KeyId, entry),
+			RemovedPaths:   []string{},
+		}
+
+		// Generate Public Key Buffer (RSA) prior to removing rsa key entry data
+		resp.Entity = keyEntityResponse(in.KeyId, entry)
+
+		if len(resp.DependentPaths) > 0 && in.Mode == KeyRemoveMode_Refuse {
+			log.Printf("[RemoveKeyPair]: Refusing to remove '%s' key, %d dependent files\n", in.KeyId, len(resp.DependentPaths))
+			return resp, nil
+		}
+		if in.DryRun {
+			return resp, nil
+		}
+		log.Printf("[RemoveKeyPair]: Removing '%s' key, mode '%s'\n", in.KeyId, in.Mode)
 
-			// Clean up RSA keys
-			delete(entity.Store.Keys, in.KeyId)
-			entity.Store.Length -= 1
-			os.Remove(path.Join(entity.KeyStorePath, in.KeyId+".pub"))
-			os.Remove(path.Join(entity.KeyStorePath, in.KeyId))
+		// Remove dependent files prior to the key
+		if in.Mode == KeyRemoveMode_Cascade {
+			resp.RemovedPaths = removeDependentFiles(resp.DependentPaths)
+		} else if len(resp.DependentPaths) > 0 {
+			log.Printf("[RemoveKeyPair]: Orphaning %d files encrypted with '%s'\n", len(resp.DependentPaths), in.KeyId)
+		}
+
+		// Remove Key from Key Store and Internal Storage, along with the keypair
+		//  files of every version
+		if err := entity.RemoveKey(in.KeyId); err != nil {
+			utils.HandleErr(err, "failed to remove key directory after key removal")
+		}
+		if _, err := storage.Internal.WriteToFile(); err != nil {
+			utils.HandleErr(err, "failed to save internal storage to file after key removal")
 		}
 
-		// Remove Key from Key Store and Internal Storage
-		delete(storage.Internal.KeyMap, in.KeyId)
-
-		return &pb.Entity{
-			Name:                   entry.Name,
-			Description:            entry.Description,
-			PublicKeyName:          publicKeyBuffer.Bytes(),
-			Algorithm:              entry.Algorithm,
-			CreatedUnixTimestamp:   entry.CreatedAt_UnixTimestamp,
-			ModifiedUnixTimestamp:  entry.ModifiedAt_UnixTimestamp, // Last modified
-			ExpiresAtUnixTimestamp: entry.ExpiresAt_UnixTimestamp,
-			SigningPublicKeyPem:    entry.SigningPublicKey_pem,
-		}, nil
+		resp.Removed = true
+		return resp, nil
 	}
 }
@@ -1,125 +1,235 @@
 package main
 
 import (
-	"bytes"
-	"compress/gzip"
 	"context"
-	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"fmt"
 	"log"
 	"openabyss/entity"
 	pb "openabyss/proto/server"
 	"openabyss/server/storage"
 	"openabyss/utils"
-	"path"
 )
 
-// KeyTarPackage encapsulates required Key information for importing
-//  and exporting keys
-type KeyTarPackage struct {
-	KeyStoreEntry storage.KeyStorage `json:"keyStoreEntry"`
-	KeyEntity     entity.Entity      `json:"keyEntity"`
-	RawPrivateKey []byte             `json:"privateKey"`
-	RawPublicKey  []byte             `json:"publicKey"`
-}
-
 // Export existing keypair
 func (s openabyss_server) ExportKey(ctx context.Context, in *pb.KeyExportRequest) (*pb.KeyExportResponse, error) {
 	log.Printf("[ExportKey]: Export key '%s' requested\n", in.KeyId)
 
+	// Packages leave the server encrypted, unless explicitly requested otherwise
+	if in.Passphrase == "" && len(in.TransportPublicKey) == 0 && !in.InsecurePlaintext {
+		return nil, errors.New("passphrase or transport key required, unless exporting insecure plaintext")
+	}
+
 	// Try and find if the key is available
-	if entry, ok := storage.Internal.KeyMap[in.KeyId]; !ok {
+	if entry, ok := storage.Internal.GetKey(in.KeyId); !ok {
 		log.Printf("[ExportKey]: Export key '%s' not found\n", in.KeyId)
 		return nil, errors.New("requested key not found")
+	} else if entry.GetState() == storage.KeyState_Destroyed {
+		return nil, errors.New("key destroyed")
 	} else {
-		// Get Key Store's Data
-		v := entity.Store.Keys[in.KeyId]
-
-		// Load Key files as bytes
-		skBuffer, errSk := ioutil.ReadFile(path.Join(entity.KeyStorePath, v.Name))
-		pkBuffer, errPk := ioutil.ReadFile(path.Join(entity.KeyStorePath, v.Name) + ".pub")
-
-		// Handle file read errors
-		if errSk != nil {
-			utils.HandleErr(errSk, "[ExportKey]: failed to read private key file")
+		// Key material held within versions leaves the server unsealed
+		exportEntry, err := unsealKeyStorage(entry)
+		if err != nil {
+			utils.HandleErr(err, "[ExportKey]: failed to unseal key material")
 			return nil, errors.New("internal error")
 		}
-		if errPk != nil {
-			utils.HandleErr(errPk, "[ExportKey]: failed to read public key file")
+
+		// Package the key along with its versions' keypair files, based on its algorithm
+		pkg, err := entity.NewKeyTarPackage(in.KeyId, exportEntry)
+		if err != nil {
+			utils.HandleErr(err, "[ExportKey]: failed to package key")
 			return nil, errors.New("internal error")
 		}
-
-		// Serialize package
-		packageBuffer, err := json.Marshal(&KeyTarPackage{
-			KeyStoreEntry: entry,
-			KeyEntity:     v,
-			RawPrivateKey: skBuffer,
-			RawPublicKey:  pkBuffer,
-		})
+		packageGzip, err := pkg.Marshal()
 		if err != nil {
 			utils.HandleErr(err, "[ExportKey]: failed to marshal Key Tar Package")
 			return nil, errors.New("internal error")
 		}
 
-		// Gzip those bytes!
-		compBuffer := bytes.NewBuffer(nil)
-		writer := gzip.NewWriter(compBuffer)
-		writer.Write(packageBuffer)
-		writer.Close()
+		// Encrypt the package for the destination server's transport key, or using
+		//  the passphrase
+		if len(in.TransportPublicKey) > 0 {
+			if packageGzip, err = entity.SealForTransport(packageGzip, in.TransportPublicKey); err != nil {
+				log.Printf("[ExportKey]: Failed to seal key '%s' for transport: %v\n", in.KeyId, err)
+				return nil, errors.New("invalid transport key")
+			}
+		} else if in.Passphrase != "" {
+			if packageGzip, err = entity.SealWithPassphrase(packageGzip, []byte(in.Passphrase)); err != nil {
+				utils.HandleErr(err, "[ExportKey]: failed to encrypt Key Tar Package")
+				return nil, errors.New("internal error")
+			}
+		} else {
+			log.Printf("[ExportKey]: Exporting key '%s' as insecure plaintext\n", in.KeyId)
+		}
 
 		// Respond with gziped data
 		log.Printf("[ExportKey]: Exported key '%s'\n", in.KeyId)
 		return &pb.KeyExportResponse{
-			KeyGzip: compBuffer.Bytes(),
+			KeyGzip: packageGzip,
 			KeyId:   entry.Name,
 		}, nil
 	}
 }
 
+// Verifies overwriting the existing key is allowed by the requested mode, refusing
+//  to overwrite keys that stored files are encrypted with unless requested to either
+//  remove those files or keep them unrecoverable. Returns the paths of those files,
+//  found prior to the key being overwritten.
+func checkOverwrittenKeyFiles(in *pb.KeyImportRequest, existingEntry storage.KeyStorage, exists bool) ([]string, error) {
+	if in.Mode != KeyRemoveMode_Refuse && in.Mode != KeyRemoveMode_Cascade && in.Mode != KeyRemoveMode_Orphan {
+		return nil, errors.New("key overwrite mode not supported")
+	}
+	if !exists {
+		return nil, nil
+	}
+	dependentPaths := keyDependentPaths(in.KeyId, existingEntry)
+	if len(dependentPaths) > 0 && in.Mode == KeyRemoveMode_Refuse {
+		log.Printf("[ImportKey]: Refusing to overwrite '%s' key, %d dependent files\n", in.KeyId, len(dependentPaths))
+		return nil, fmt.Errorf("%d stored files are encrypted with key '%s', issue cascade or orphan to overwrite it", len(dependentPaths), in.KeyId)
+	}
+	return dependentPaths, nil
+}
+
+// Handles the stored files encrypted with the overwritten key once imported, removing
+//  them if requested
+func handleOverwrittenKeyFiles(in *pb.KeyImportRequest, dependentPaths []string) {
+	if len(dependentPaths) == 0 {
+		return
+	}
+
+	if in.Mode == KeyRemoveMode_Cascade {
+		removedPaths := removeDependentFiles(dependentPaths)
+		log.Printf("[ImportKey]: Removed %d files encrypted with the overwritten '%s' key\n", len(removedPaths), in.KeyId)
+		if _, err := storage.Internal.WriteToFile(); err != nil {
+			utils.HandleErr(err, "[ImportKey]: failed to save internal storage")
+		}
+	} else {
+		log.Printf("[ImportKey]: Orphaning %d files encrypted with the overwritten '%s' key\n", len(dependentPaths), in.KeyId)
+	}
+}
+
 // Import key to server
 func (s openabyss_server) ImportKey(ctx context.Context, in *pb.KeyImportRequest) (*pb.KeyImportResponse, error) {
 	log.Printf("[ImportKey]: Import key '%s' requested\n", in.KeyId)
+	if err := entity.ValidateKeyName(in.KeyId); err != nil {
+		return nil, err
+	}
+	unlockKey := lockKeys(in.KeyId)
+	defer unlockKey()
 
 	// Check if key exists
-	if _, ok := storage.Internal.KeyMap[in.KeyId]; ok && !in.Force {
+	if existingEntry, ok := storage.Internal.GetKey(in.KeyId); ok && !in.Force {
 		log.Printf("[ImportKey]: Import key '%s' duplicate found\n", in.KeyId)
 		return nil, errors.New("duplicate key found, issue force=true to overwrite duplicate")
+	} else if dependentPaths, err := checkOverwrittenKeyFiles(in, existingEntry, ok); err != nil {
+		return nil, err
+	} else if in.Format != "" && in.Format != "package" {
+		// Keys of standard formats, rather than exported packages
+		var existing *storage.KeyStorage
+		if ok {
+			existing = &existingEntry
+		}
+		if err := importStandardKey(in, existing, dependentPaths); err != nil {
+			return nil, err
+		}
+		return &pb.KeyImportResponse{}, nil
 	} else {
+		// Decrypt packages transferred from other servers, or exported using a passphrase
+		packageGzip := in.KeyGzip
+		if entity.IsTransportSealed(packageGzip) {
+			sk, err := getTransportKey()
+			if err == nil {
+				packageGzip, err = entity.OpenTransport(packageGzip, sk)
+			}
+			if err != nil {
+				log.Printf("[ImportKey]: Failed to open key '%s' transferred package: %v\n", in.KeyId, err)
+				return nil, errors.New("key package not sealed for this server's transport key")
+			}
+		} else if entity.IsPassphraseSealed(packageGzip) {
+			if in.Passphrase == "" {
+				return nil, errors.New("key package is encrypted, passphrase required")
+			}
+			var err error
+			if packageGzip, err = entity.OpenWithPassphrase(packageGzip, []byte(in.Passphrase)); err != nil {
+				log.Printf("[ImportKey]: Failed to decrypt key '%s' package: %v\n", in.KeyId, err)
+				return nil, errors.New("invalid passphrase or corrupted package")
+			}
+		}
+
 		// Unpack & unmarshal blob
-		if reader, err := gzip.NewReader(bytes.NewBuffer(in.KeyGzip)); err != nil {
-			log.Printf("[ImportKey]: Failed to unpack key '%s'\n", in.KeyId)
-			return nil, errors.New("failed unpack archive")
-		} else {
-			var pkg KeyTarPackage
-			buffer, _ := ioutil.ReadAll(reader)
-			json.Unmarshal(buffer, &pkg)
+		pkg, err := entity.UnmarshalKeyTarPackage(packageGzip)
+		if err != nil {
+			log.Printf("[ImportKey]: Failed to unpack key '%s': %v\n", in.KeyId, err)
+			return nil, fmt.Errorf("invalid key package: %v", err)
+		}
 
-			// Make sure package entries' key id matches what's intended
-			pkg.KeyEntity.Name = in.KeyId
-			pkg.KeyStoreEntry.Name = in.KeyId
+		// Make sure package entries' key id matches what's intended
+		pkg.KeyStoreEntry.Name = in.KeyId
 
-			// Add Key to Key Store
-			entity.Store.Keys[in.KeyId] = pkg.KeyEntity
+		// Keep the overwritten key's unique identifier, otherwise the package's unless
+		//  missing or used by another key
+		if name, found := storage.Internal.GetKeyNameByUid(pkg.KeyStoreEntry.Uid); ok {
+			pkg.KeyStoreEntry.Uid = existingEntry.Uid
+		} else if pkg.KeyStoreEntry.Uid == "" || (found && name != in.KeyId) {
+			pkg.KeyStoreEntry.Uid = storage.GenerateKeyUid()
+		}
 
-			// Add Key to internal storage
-			storage.Internal.KeyMap[in.KeyId] = pkg.KeyStoreEntry
+		// Validate the key material of every version prior to modifying the Key Store
+		if err := pkg.Validate(in.KeyId); err != nil {
+			log.Printf("[ImportKey]: Invalid key '%s' package: %v\n", in.KeyId, err)
+			return nil, fmt.Errorf("invalid key package: %v", err)
+		}
 
-			// Overwrite key if force requested
-			if ok {
-				log.Printf("[ImportKey]: Overwriting keys for '%s'\n", pkg.KeyEntity.Name)
+		// Seal the key material held within versions prior to storing it
+		for idx := range pkg.KeyStoreEntry.Versions {
+			if err := sealKeyVersion(&pkg.KeyStoreEntry.Versions[idx]); err != nil {
+				utils.HandleErr(err, "[ImportKey]: failed to seal key material")
+				return nil, errors.New("internal error")
 			}
+		}
 
-			// Save Private & Public key files
-			skPath := path.Join(entity.KeyStorePath, pkg.KeyEntity.Name)
-			ioutil.WriteFile(skPath, pkg.RawPrivateKey, 0644)
-			log.Printf("[ImportKey]: Private key saved to '%s'\n", skPath)
+		// Back up the key, restoring it if the import fails, leaving the Key Store
+		//  untouched
+		backup, err := entity.BackupKey(in.KeyId)
+		if err != nil {
+			utils.HandleErr(err, "[ImportKey]: failed to back up key")
+			return nil, errors.New("internal error")
+		}
+		restoreKey := func() {
+			if err := backup.Restore(); err != nil {
+				utils.HandleErr(err, "[ImportKey]: failed to restore key")
+			}
+		}
 
-			pkPath := path.Join(entity.KeyStorePath, pkg.KeyEntity.Name) + ".pub"
-			ioutil.WriteFile(pkPath, pkg.RawPublicKey, 0644)
-			log.Printf("[ImportKey]: Public key saved to '%s'\n", pkPath)
+		// Save the keypair files of every version, adding them to the Key Store
+		if err := pkg.Import(in.KeyId); err != nil {
+			utils.HandleErr(err, "[ImportKey]: failed to save key files")
+			restoreKey()
+			return nil, errors.New("internal error")
+		}
 
-			return &pb.KeyImportResponse{}, nil
+		// Overwrite key if force requested, removing keypair files not overwritten by
+		//  the package
+		if ok {
+			log.Printf("[ImportKey]: Overwriting keys for '%s'\n", in.KeyId)
+			for _, keyVersion := range existingEntry.Versions {
+				if pkg.VersionKeyPair(keyVersion.Version) == nil {
+					removeKeyVersionFiles(in.KeyId, keyVersion.Version)
+				}
+			}
+		}
+
+		// Add Key to the key store
+		if err := entity.SaveKey(pkg.KeyStoreEntry); err != nil {
+			utils.HandleErr(err, "[ImportKey]: failed to save key")
+			restoreKey()
+			return nil, errors.New("internal error")
+		}
+		if ok {
+			handleOverwrittenKeyFiles(in, dependentPaths)
 		}
+		log.Printf("[ImportKey]: Imported key '%s'\n", in.KeyId)
+
+		return &pb.KeyImportResponse{}, nil
 	}
 }
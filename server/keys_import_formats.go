@@ -0,0 +1,97 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/configuration"
+	"openabyss/server/storage"
+	"openabyss/utils"
+	"time"
+)
+
+// Imports an RSA or Ed25519 private key of a standard format (pem, openssh or jwk) as
+//  a new key. RSA keys wrap data keys using the imported keypair, Ed25519 keys become
+//  the signing key of the new key, their private key never being stored. The cipher
+//  key material is generated by the server. Stored files encrypted with the overwritten
+//  key, at given paths, are handled per the requested mode.
+func importStandardKey(in *pb.KeyImportRequest, existingEntry *storage.KeyStorage, dependentPaths []string) error {
+	sk, err := utils.ParseStandardPrivateKey(in.Format, in.KeyGzip, in.Passphrase)
+	if err != nil {
+		log.Printf("[ImportKey]: Failed to parse key '%s' of '%s' format: %v\n", in.KeyId, in.Format, err)
+		return fmt.Errorf("invalid %s key: %v", in.Format, err)
+	}
+
+	keyStorage := storage.KeyStorage{
+		Uid:                      storage.GenerateKeyUid(),
+		Name:                     in.KeyId,
+		CipherAlgorithm:          configuration.LoadedConfig.DefaultCipher,
+		CreatedAt_UnixTimestamp:  uint64(time.Now().UnixMilli()),
+		ModifiedAt_UnixTimestamp: uint64(time.Now().UnixMilli()),
+	}
+	var keyVersion storage.KeyVersion
+	switch key := sk.(type) {
+	case *rsa.PrivateKey:
+		keyStorage.Algorithm = "rsa"
+		keyStorage.OAEPHash = configuration.LoadedConfig.DefaultOAEPHash
+		material := &entity.KeyMaterial{
+			StoreName: entity.VersionKeyName(in.KeyId, 1),
+			OAEPHash:  keyStorage.OAEPHash,
+		}
+		if err := entity.NewRSAKeyMaterial(material, key); err != nil {
+			log.Printf("[ImportKey]: Failed to import rsa key '%s': %v\n", in.KeyId, err)
+			return fmt.Errorf("invalid rsa key: %v", err)
+		}
+		keyStorage.KeySize = material.KeySize
+		keyVersion = storage.KeyVersion{
+			Version:                 1,
+			CipherEncKey:            material.CipherEncKey,
+			State:                   storage.KeyVersion_Enabled,
+			CreatedAt_UnixTimestamp: uint64(time.Now().UnixMilli()),
+		}
+		if err := sealKeyVersion(&keyVersion); err != nil {
+			utils.HandleErr(err, "[ImportKey]: failed to seal key material")
+			return errors.New("internal error")
+		}
+	case ed25519.PrivateKey:
+		keyStorage.Algorithm = "ed25519"
+		if keyVersion, err = generateKeyVersion(in.KeyId, &keyStorage, 1); err != nil {
+			log.Printf("[ImportKey]: Could not generate key '%s' material: %v\n", in.KeyId, err)
+			return errors.New("internal error")
+		}
+		keyStorage.SigningPublicKey_pem = base64.StdEncoding.EncodeToString(utils.ED25519_to_pem(key.Public().(ed25519.PublicKey)))
+	}
+	keyStorage.Versions = []storage.KeyVersion{keyVersion}
+	keyStorage.SetState(storage.KeyState_Active)
+
+	// Keep the overwritten key's unique identifier, its stored files being handled
+	//  per the requested mode once imported
+	if existingEntry != nil {
+		keyStorage.Uid = existingEntry.Uid
+	}
+
+	// Overwrite key if force requested, removing keypair files not overwritten by
+	//  the imported key
+	if existingEntry != nil {
+		log.Printf("[ImportKey]: Overwriting keys for '%s'\n", in.KeyId)
+		for _, existingVersion := range existingEntry.Versions {
+			if keyStorage.Algorithm != "rsa" || existingVersion.Version != 1 {
+				removeKeyVersionFiles(in.KeyId, existingVersion.Version)
+			}
+		}
+	}
+
+	// Add Key to the key store
+	if err := entity.SaveKey(keyStorage); err != nil {
+		utils.HandleErr(err, "[ImportKey]: failed to save key")
+		return errors.New("internal error")
+	}
+	handleOverwrittenKeyFiles(in, dependentPaths)
+	log.Printf("[ImportKey]: Imported %s key '%s' from '%s' format\n", keyStorage.Algorithm, in.KeyId, in.Format)
+	return nil
+}
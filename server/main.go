@@ -19,6 +19,9 @@ func onSignalChannel_cleanup(sigChan chan os.Signal) {
 	<-sigChan
 	log.Println("[Clean Up] Clean-up Signal Issued: Cleaning up...")
 
+	log.Println("[Clean Up]: Saving counted key encryptions")
+	saveCountedEncryptions()
+
 	log.Println("[Clean Up]: Closing up Internal Storage")
 	if err := storage.Close(); err != nil {
 		log.Println("[Clean Up]: Error closing up Internal Storage:", err)
@@ -47,7 +50,10 @@ func main() {
 	var s *grpc.Server
 	if insecure {
 		log.Println("[server.main] no TLS")
-		s = grpc.NewServer()
+		s = grpc.NewServer(
+			grpc.UnaryInterceptor(sealedUnaryInterceptor),
+			grpc.StreamInterceptor(sealedStreamInterceptor),
+		)
 	} else {
 		// Create TLS Credentials
 		creds, err := credentials.NewServerTLSFromFile(tlsCert, tlsKey)
@@ -56,7 +62,11 @@ func main() {
 		}
 
 		log.Printf("[server.main] TLS loaded (cert=%s) (key=%s)\n", tlsCert, tlsKey)
-		s = grpc.NewServer(grpc.Creds(creds))
+		s = grpc.NewServer(
+			grpc.Creds(creds),
+			grpc.UnaryInterceptor(sealedUnaryInterceptor),
+			grpc.StreamInterceptor(sealedStreamInterceptor),
+		)
 	}
 	pb.RegisterOpenAbyssServer(s, openabyss_server{})
 	log.Printf("[server.main] server listening at %v", lis.Addr())
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"log"
+	"openabyss/entity"
+	"openabyss/server/configuration"
+	"openabyss/server/storage"
+	"openabyss/utils"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// Reads the base64 encoded master key from the key file
+func readMasterKeyFile(keyFile string) ([]byte, error) {
+	buffer, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(string(buffer)))
+}
+
+// Generates a random master key, writing it base64 encoded into the key file, only
+//  readable by the server
+func generateMasterKeyFile(keyFile string) ([]byte, error) {
+	key, err := entity.GenerateMasterKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(path.Dir(keyFile), 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Overwrites the master key file prior to removing it, once the master key is split
+//  into unseal shares
+func removeMasterKeyFile(keyFile string) error {
+	file, err := os.OpenFile(keyFile, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	stat, err := file.Stat()
+	if err == nil {
+		_, err = file.Write(make([]byte, stat.Size()))
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(keyFile)
+}
+
+// Sets up the master key on first start, deriving it from the passphrase if provided,
+//  otherwise reading it from the key file, which is generated if missing
+func setupMasterKey(passphrase string) error {
+	config := configuration.LoadedConfig.MasterKey
+	masterKeyStorage := &storage.MasterKeyStorage{
+		CreatedAt_UnixTimestamp: uint64(time.Now().UnixMilli()),
+	}
+
+	var key []byte
+	var err error
+	if passphrase != "" {
+		salt, err := entity.GenerateMasterKeySalt()
+		if err != nil {
+			return err
+		}
+		masterKeyStorage.Kdf = entity.MasterKeyKdf_Argon2id
+		masterKeyStorage.Salt = base64.StdEncoding.EncodeToString(salt)
+		masterKeyStorage.Argon2Time = entity.Argon2Time
+		masterKeyStorage.Argon2Memory = entity.Argon2Memory
+		masterKeyStorage.Argon2Threads = entity.Argon2Threads
+		key = entity.DeriveMasterKey([]byte(passphrase), salt, entity.Argon2Time, entity.Argon2Memory, entity.Argon2Threads)
+		log.Println("[setupMasterKey]: Derived master key from passphrase")
+	} else if utils.FileExists(config.KeyFile) {
+		masterKeyStorage.Kdf = entity.MasterKeyKdf_File
+		if key, err = readMasterKeyFile(config.KeyFile); err != nil {
+			return err
+		}
+		log.Printf("[setupMasterKey]: Read master key from '%s'\n", config.KeyFile)
+	} else {
+		masterKeyStorage.Kdf = entity.MasterKeyKdf_File
+		if key, err = generateMasterKeyFile(config.KeyFile); err != nil {
+			return err
+		}
+		log.Printf("[setupMasterKey]: Generated master key file '%s', keep it safe & apart from backups\n", config.KeyFile)
+	}
+
+	if err := entity.SetMasterKey(key); err != nil {
+		return err
+	}
+	if masterKeyStorage.Check, err = entity.MasterKeyCheck(); err != nil {
+		return err
+	}
+	storage.Internal.MasterKey = masterKeyStorage
+	_, err = storage.Internal.WriteToFile()
+	return err
+}
+
+// Unlocks the master key sealing key material at rest, either derived from the
+//  operator's passphrase or read from the key file, depending on how it was set up.
+//  The master key is set up on first start. Servers initialized with unseal shares
+//  remain sealed until unsealed by operators.
+func unlockMasterKey() error {
+	config := configuration.LoadedConfig.MasterKey
+	passphrase := ""
+	if config.PassphraseEnv != "" {
+		passphrase = os.Getenv(config.PassphraseEnv)
+	}
+
+	masterKeyStorage := storage.Internal.MasterKey
+	if masterKeyStorage == nil {
+		return setupMasterKey(passphrase)
+	}
+
+	var key []byte
+	var err error
+	switch masterKeyStorage.Kdf {
+	case entity.MasterKeyKdf_Shamir:
+		// Master key file left over from prior to being split
+		if utils.FileExists(config.KeyFile) {
+			if err := removeMasterKeyFile(config.KeyFile); err != nil {
+				return errors.New("failed to remove master key file '" + config.KeyFile + "' no longer used: " + err.Error())
+			}
+			log.Printf("[unlockMasterKey]: Removed master key file '%s' no longer used\n", config.KeyFile)
+		}
+		log.Printf("[unlockMasterKey]: Server sealed, awaiting %d unseal shares\n", masterKeyStorage.Threshold)
+		return nil
+	case entity.MasterKeyKdf_Argon2id:
+		if passphrase == "" {
+			return errors.New("master key passphrase required, provided through '" + config.PassphraseEnv + "'")
+		}
+		salt, err := base64.StdEncoding.DecodeString(masterKeyStorage.Salt)
+		if err != nil {
+			return err
+		}
+		key = entity.DeriveMasterKey([]byte(passphrase), salt, masterKeyStorage.Argon2Time, masterKeyStorage.Argon2Memory, masterKeyStorage.Argon2Threads)
+	case entity.MasterKeyKdf_File:
+		if key, err = readMasterKeyFile(config.KeyFile); err != nil {
+			return errors.New("failed to read master key file '" + config.KeyFile + "': " + err.Error())
+		}
+	default:
+		return errors.New("master key kdf '" + masterKeyStorage.Kdf + "' not supported")
+	}
+
+	if err := entity.SetMasterKey(key); err != nil {
+		return err
+	}
+	if !entity.VerifyMasterKey(masterKeyStorage.Check) {
+		entity.ClearMasterKey()
+		return errors.New("invalid master key")
+	}
+	log.Println("[unlockMasterKey]: Master key unlocked")
+	return nil
+}
+
+// Seals the private key material of the key's version using the master key
+func sealKeyVersion(keyVersion *storage.KeyVersion) error {
+	var err error
+	if keyVersion.CipherEncKey, err = entity.SealString(keyVersion.CipherEncKey); err != nil {
+		return err
+	}
+	keyVersion.PrivateKey_pem, err = entity.SealString(keyVersion.PrivateKey_pem)
+	return err
+}
+
+// Returns a copy of the stored key with the private key material of its versions
+//  unsealed, such as when leaving the server
+func unsealKeyStorage(internalKey storage.KeyStorage) (storage.KeyStorage, error) {
+	versions := make([]storage.KeyVersion, len(internalKey.Versions))
+	for idx, keyVersion := range internalKey.Versions {
+		var err error
+		if keyVersion.CipherEncKey, err = entity.UnsealString(keyVersion.CipherEncKey); err != nil {
+			return internalKey, err
+		}
+		if keyVersion.PrivateKey_pem, err = entity.UnsealString(keyVersion.PrivateKey_pem); err != nil {
+			return internalKey, err
+		}
+		versions[idx] = keyVersion
+	}
+	internalKey.Versions = versions
+	return internalKey, nil
+}
+
+// Seals the private key material of keys stored prior to the master key
+func migrateSealKeyMaterial() {
+	for name := range storage.Internal.GetKeys() {
+		migrateSealKeyVersions(name)
+	}
+}
+
+// Seals the private key material of the key's versions stored prior to the master key,
+//  re-reading the key once locked
+func migrateSealKeyVersions(name string) {
+	unlockKey := lockKeys(name)
+	defer unlockKey()
+
+	internalKey, ok := storage.Internal.GetKey(name)
+	if !ok {
+		return
+	}
+
+	// Modify a copy of the versions, the Key Store's entry sharing them until saved
+	internalKey.Versions = append([]storage.KeyVersion(nil), internalKey.Versions...)
+	keyModified := false
+	for idx := range internalKey.Versions {
+		keyVersion := &internalKey.Versions[idx]
+		if (keyVersion.CipherEncKey == "" || entity.IsSealedString(keyVersion.CipherEncKey)) &&
+			(keyVersion.PrivateKey_pem == "" || entity.IsSealedString(keyVersion.PrivateKey_pem)) {
+			continue
+		}
+		if err := sealKeyVersion(keyVersion); err != nil {
+			log.Printf("[migrateSealKeyMaterial]: Failed to seal key '%s' version '%d': %v\n", name, keyVersion.Version, err)
+			continue
+		}
+		keyModified = true
+	}
+
+	if keyModified {
+		if err := entity.SaveKey(internalKey); err != nil {
+			log.Printf("[migrateSealKeyMaterial]: Failed to save key '%s': %v\n", name, err)
+			return
+		}
+		log.Printf("[migrateSealKeyMaterial]: Sealed key '%s' material\n", name)
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/configuration"
+	"openabyss/server/storage"
+	"os"
+	"path"
+	"time"
+)
+
+// Returned when the blob's data does not decrypt using the key
+var errBlobData = errors.New("blob data does not decrypt using the key")
+
+// Decrypts the stored blob entirely, returning the SHA-256 of its data. Compressed data
+//  is decompressed entirely, verifying unauthenticated ciphers decrypt using the key.
+func hashBlobData(keyName string, internalKey storage.KeyStorage, fsFile *storage.FileStorage, blobPath string) ([]byte, error) {
+	blobFile, err := os.Open(blobPath)
+	if err != nil {
+		return nil, err
+	}
+	defer blobFile.Close()
+
+	reader, header, err := newKeyDecryptReader(keyName, internalKey, fsFile, blobFile)
+	if err != nil {
+		return nil, err
+	}
+	dataHash := sha256.New()
+	dataReader := io.TeeReader(reader, dataHash)
+	if header.Compressed {
+		gzipReader, err := gzip.NewReader(dataReader)
+		if err != nil {
+			return nil, errBlobData
+		}
+		if _, err := io.Copy(io.Discard, gzipReader); err != nil {
+			return nil, errBlobData
+		}
+	}
+	if _, err := io.Copy(io.Discard, dataReader); err != nil {
+		return nil, errBlobData
+	}
+	return dataHash.Sum(nil), nil
+}
+
+// Whether the stored file may have been encrypted with the key. Files stored prior to
+//  recording their key are matched by their blob's header, or by decrypting legacy
+//  blobs using the key. Files unable to be checked may have been.
+func fileMayUseKey(keyName string, internalKey storage.KeyStorage, fsFile *storage.FileStorage) bool {
+	if fsFile.KeyUid != "" {
+		return fsFile.KeyUid == internalKey.Uid
+	}
+
+	blobPath := path.Join(storage.InternalStoragePath, fsFile.Name)
+	blobFile, err := os.Open(blobPath)
+	if err != nil {
+		return true
+	}
+	header, err := entity.ReadBlobHeader(bufio.NewReader(blobFile))
+	blobFile.Close()
+	if err == nil {
+		return header.KeyId == internalKey.Uid
+	} else if err != entity.ErrLegacyBlob {
+		return true
+	}
+
+	_, err = hashBlobData(keyName, internalKey, fsFile, blobPath)
+	return err != errBlobData
+}
+
+// Re-encrypts given stored file into the current blob format using the key's cipher
+//  algorithm and latest version, unless the file already is in the current format.
+//  Files encrypted with other keys are skipped.
+func migrateFileCipher(keyName string, material *keyMaterial, fsFile *storage.FileStorage) (bool, error) {
+	if fsFile.KeyUid != "" && fsFile.KeyUid != material.internalKey.Uid {
+		return false, nil
+	}
+
+	blobPath := path.Join(storage.InternalStoragePath, fsFile.Name)
+	blobFile, err := os.Open(blobPath)
+	if err != nil {
+		return false, err
+	}
+	defer blobFile.Close()
+
+	// Blobs in the current format record the key that encrypted them
+	if header, err := entity.ReadBlobHeader(bufio.NewReader(blobFile)); err == nil && header.KeyId != material.internalKey.Uid {
+		return false, nil
+	}
+	if _, err := blobFile.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	reader, header, err := newKeyDecryptReader(keyName, material.internalKey, fsFile, blobFile)
+	if err != nil {
+		return false, err
+	}
+
+	// Only legacy blobs, unauthenticated ciphers and blobs without a per-file data
+	//  key require migration
+	isLegacyBlob := header.Version == 0
+	if !isLegacyBlob && header.CipherAlgorithm != entity.CipherAES_CFB && len(header.WrappedKey) > 0 {
+		return false, nil
+	}
+
+	// Unauthenticated ciphers have no integrity, so make sure the key actually
+	//  decrypts the data prior to replacing it
+	dataHash, err := hashBlobData(keyName, material.internalKey, fsFile, blobPath)
+	if err == errBlobData {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	// Re-encrypt into a partial blob, only moving it in place once it decrypts to
+	//  the same data
+	partialBlobPath := blobPath + ".part"
+	destWriter, err := os.Create(partialBlobPath)
+	if err != nil {
+		return false, err
+	}
+	encWriter, err := newKeyEncryptWriter(material, header.Compressed, destWriter)
+	if err == nil {
+		if _, err = io.Copy(encWriter, reader); err == nil {
+			err = encWriter.Close()
+		}
+	}
+	destWriter.Close()
+	if err == nil {
+		var partialHash []byte
+		if partialHash, err = hashBlobData(keyName, material.internalKey, fsFile, partialBlobPath); err == nil && !bytes.Equal(partialHash, dataHash) {
+			err = errors.New("migrated blob data mismatch")
+		}
+	}
+	if err == nil {
+		err = os.Rename(partialBlobPath, blobPath)
+	}
+	if err != nil {
+		os.Remove(partialBlobPath)
+		return false, err
+	}
+
+	// Keep track of the new cipher & key
+	fsFile.CipherAlgorithm = material.internalKey.CipherAlgorithm
+	fsFile.KeyUid = material.internalKey.Uid
+	fsFile.KeyVersion = material.version.Version
+	fsFile.ModifiedAt_UnixTimestamp = uint64(time.Now().Unix())
+	return true, storage.Internal.UpdateStorage(fsFile.Path, *fsFile)
+}
+
+// Records the key that encrypted stored files written prior to keys being tracked,
+//  reading it from the blob's header. Legacy blobs hold no key information.
+func migrateFileKeyUids() {
+	modified := false
+	for _, fsFile := range storage.Internal.GetAllStorage() {
+		if fsFile.KeyUid != "" {
+			continue
+		}
+
+		blobFile, err := os.Open(path.Join(storage.InternalStoragePath, fsFile.Name))
+		if err != nil {
+			continue
+		}
+		header, err := entity.ReadBlobHeader(bufio.NewReader(blobFile))
+		blobFile.Close()
+		if err != nil {
+			continue
+		}
+		if _, ok := storage.Internal.GetKeyNameByUid(header.KeyId); !ok {
+			continue
+		}
+
+		fsFile.KeyUid = header.KeyId
+		fsFile.KeyVersion = header.KeyVersion
+		if err := storage.Internal.UpdateStorage(fsFile.Path, fsFile); err == nil {
+			modified = true
+			log.Printf("[migrateFileKeyUids]: Recorded key of '%s'\n", fsFile.Path)
+		}
+	}
+
+	if modified {
+		if _, err := storage.Internal.WriteToFile(); err != nil {
+			log.Printf("[migrateFileKeyUids]: Failed to save internal storage: %v\n", err)
+		}
+	}
+}
+
+// Upgrades the key's unauthenticated cipher to the default cipher, re-reading the key
+//  once locked. Returns the saved key.
+func upgradeKeyCipher(keyName string) (storage.KeyStorage, error) {
+	unlockKey := lockKeys(keyName)
+	defer unlockKey()
+
+	internalKey, ok := storage.Internal.GetKey(keyName)
+	if !ok {
+		return internalKey, errors.New("key id not found")
+	}
+	if internalKey.CipherAlgorithm != entity.CipherAES_CFB {
+		return internalKey, nil
+	}
+
+	cipherAlgorithm := configuration.LoadedConfig.DefaultCipher
+	if _, err := entity.GetSelectableCipherAlgorithm(cipherAlgorithm); err != nil {
+		cipherAlgorithm = entity.DefaultCipherAlgorithm
+	}
+	log.Printf("[MigrateStorageCipher]: Upgrading '%s' cipher '%s' -> '%s'\n", keyName, internalKey.CipherAlgorithm, cipherAlgorithm)
+	internalKey.CipherAlgorithm = cipherAlgorithm
+	internalKey.ModifiedAt_UnixTimestamp = uint64(time.Now().UnixMilli())
+	if err := entity.SaveKey(internalKey); err != nil {
+		log.Printf("[MigrateStorageCipher]: Failed to save '%s' key: %v\n", keyName, err)
+		return internalKey, errors.New("internal error")
+	}
+	return internalKey, nil
+}
+
+// Re-encrypts legacy stored files, encrypted using the given key, into the current
+//  blob format with per-file data keys and the key's authenticated cipher algorithm
+func (s openabyss_server) MigrateStorageCipher(ctx context.Context, in *pb.CipherMigrationRequest) (*pb.CipherMigrationResponse, error) {
+	log.Printf("[MigrateStorageCipher]: Migrating '%s' files encrypted with '%s'\n", in.Path, in.KeyName)
+
+	internalKey, ok := storage.Internal.GetKey(in.KeyName)
+	if !ok {
+		log.Printf("[MigrateStorageCipher]: Key '%s' not found\n", in.KeyName)
+		return nil, errors.New("key id not found")
+	}
+	if err := checkKeyState(in.KeyName, internalKey, true); err != nil {
+		return nil, err
+	}
+	if keyRequiresSignature(internalKey) {
+		if !verifyKeySignature(internalKey, []byte(in.Path), in.PathSignature) {
+			log.Println("[MigrateStorageCipher]: Path signature invalid")
+			return nil, errors.New("invalid signature")
+		}
+		log.Println("[MigrateStorageCipher]: Path signature validated")
+	}
+
+	fsStorage, err := storage.Internal.GetSubStorageByPath(in.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upgrade the key's cipher, so that new encryptions are authenticated
+	if internalKey.CipherAlgorithm == entity.CipherAES_CFB {
+		if internalKey, err = upgradeKeyCipher(in.KeyName); err != nil {
+			return nil, err
+		}
+	}
+
+	material, err := loadLatestKeyMaterial(in.KeyName, internalKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CipherMigrationResponse{
+		MigratedPaths: []string{},
+		FailedPaths:   []string{},
+	}
+	for _, fsFile := range fsStorage.GetAllStorage() {
+		if migrated, err := migrateFileCipher(in.KeyName, material, &fsFile); err != nil {
+			log.Printf("[MigrateStorageCipher]: Failed to migrate '%s': %v\n", fsFile.Path, err)
+			resp.FailedPaths = append(resp.FailedPaths, fsFile.Path)
+		} else if migrated {
+			log.Printf("[MigrateStorageCipher]: Migrated '%s'\n", fsFile.Path)
+			resp.MigratedPaths = append(resp.MigratedPaths, fsFile.Path)
+		}
+	}
+
+	if _, err := storage.Internal.WriteToFile(); err != nil {
+		log.Printf("[MigrateStorageCipher]: Failed to save internal storage: %v\n", err)
+	}
+	return resp, nil
+}
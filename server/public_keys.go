@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/storage"
+)
+
+// Resolves the public key of the stored key's version. Keys requiring signed requests
+//  are identified by their signing public key, keys holding no public key return an
+//  error.
+func versionPublicKey(keyName string, internalKey storage.KeyStorage, keyVersion storage.KeyVersion) (crypto.PublicKey, error) {
+	algorithm, err := entity.GetKeyAlgorithm(internalKey.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKeyPem []byte
+	if algorithm.CanSign() {
+		if publicKeyPem, err = base64.StdEncoding.DecodeString(internalKey.SigningPublicKey_pem); err != nil {
+			return nil, err
+		}
+	} else {
+		material, err := versionKeyMaterial(keyName, internalKey, keyVersion)
+		if err != nil {
+			return nil, err
+		}
+		publicKeyPem = algorithm.PublicKeyPem(material)
+	}
+	if len(publicKeyPem) == 0 {
+		return nil, errors.New("key has no public key")
+	}
+	return entity.ParsePublicKeyPem(publicKeyPem)
+}
+
+// Computes the fingerprint of the key's latest version, empty if the key holds no
+//  public key
+func keyFingerprint(keyName string, internalKey storage.KeyStorage) string {
+	latestVersion := internalKey.LatestVersion()
+	if latestVersion == nil {
+		return ""
+	}
+	pk, err := versionPublicKey(keyName, internalKey, *latestVersion)
+	if err != nil {
+		return ""
+	}
+	fingerprint, err := entity.PublicKeyFingerprint(pk)
+	if err != nil {
+		return ""
+	}
+	return fingerprint
+}
+
+// Obtains the public key of the key's version in the requested standard format, along
+//  with its fingerprint, verifying keys out of band
+func (s openabyss_server) GetPublicKey(ctx context.Context, in *pb.PublicKeyRequest) (*pb.PublicKey, error) {
+	entry, ok := storage.Internal.GetKey(in.KeyId)
+	if !ok {
+		log.Printf("[GetPublicKey]: '%s' key not found\n", in.KeyId)
+		return nil, errors.New("entity key-id not found")
+	}
+	if in.Format == "" {
+		in.Format = entity.PublicKeyFormat_PEM
+	}
+
+	keyVersion := entry.LatestVersion()
+	if in.Version != 0 {
+		keyVersion = entry.GetVersion(in.Version)
+	}
+	if keyVersion == nil {
+		log.Printf("[GetPublicKey]: '%s' key version '%d' not found\n", in.KeyId, in.Version)
+		return nil, errors.New("key version not found")
+	}
+	if keyVersion.State == storage.KeyVersion_Destroyed {
+		return nil, errors.New("key version destroyed")
+	}
+
+	pk, err := versionPublicKey(in.KeyId, entry, *keyVersion)
+	if err != nil {
+		log.Printf("[GetPublicKey]: '%s' key version '%d' public key unavailable: %v\n", in.KeyId, keyVersion.Version, err)
+		return nil, err
+	}
+	publicKey, err := entity.MarshalPublicKey(pk, in.Format)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint, err := entity.PublicKeyFingerprint(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PublicKey{
+		KeyId:       in.KeyId,
+		Version:     keyVersion.Version,
+		Algorithm:   entry.Algorithm,
+		Format:      in.Format,
+		PublicKey:   publicKey,
+		Fingerprint: fingerprint,
+	}, nil
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/storage"
+	"openabyss/utils"
+	"os"
+	"path"
+	"sync"
+	"time"
+)
+
+// Keys currently being rotated, by RPCs or the key scheduler
+var (
+	rotatingKeysMutex sync.Mutex
+	rotatingKeys      = map[string]bool{}
+)
+
+// Marks the key as being rotated. Returns false if the key already is.
+func beginKeyRotation(keyName string) bool {
+	rotatingKeysMutex.Lock()
+	defer rotatingKeysMutex.Unlock()
+	if rotatingKeys[keyName] {
+		return false
+	}
+	rotatingKeys[keyName] = true
+	return true
+}
+
+// Marks the key's rotation as done
+func endKeyRotation(keyName string) {
+	rotatingKeysMutex.Lock()
+	defer rotatingKeysMutex.Unlock()
+	delete(rotatingKeys, keyName)
+}
+
+// Moves the stored file's blob onto the key's new version. Blobs with a per-file data
+//  key only have their data key re-wrapped, while others are re-encrypted. Returns
+//  whether the file was encrypted with the key and rotated.
+func rotateFileBlob(keyName string, newMaterial *keyMaterial, fsFile *storage.FileStorage) (bool, error) {
+	blobPath := path.Join(storage.InternalStoragePath, fsFile.Name)
+	blobFile, err := os.Open(blobPath)
+	if err != nil {
+		return false, err
+	}
+	defer blobFile.Close()
+
+	header, err := entity.ReadBlobHeader(bufio.NewReader(blobFile))
+	if err != nil && err != entity.ErrLegacyBlob {
+		return false, err
+	} else if err == nil && (header.KeyId != newMaterial.internalKey.Uid || header.KeyVersion == newMaterial.version.Version) {
+		return false, nil
+	} else if err == entity.ErrLegacyBlob && fileCipherAlgorithm(fsFile) == entity.CipherAES_CFB {
+		// Unauthenticated legacy blobs can't be attributed to a key, remaining
+		//  decryptable using the key's first version
+		return false, nil
+	}
+
+	// Rotate into a partial blob, only moving it in place once done
+	partialBlobPath := blobPath + ".rotate"
+	destWriter, err := os.Create(partialBlobPath)
+	if err != nil {
+		return false, err
+	}
+	if err := func() error {
+		if _, err := blobFile.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+
+		// Re-wrap the data key, leaving the encrypted data untouched
+		if header != nil && len(header.WrappedKey) > 0 {
+			srcReader := bufio.NewReader(blobFile)
+			header, err := entity.ReadBlobHeader(srcReader)
+			if err != nil {
+				return err
+			}
+			oldMaterial, err := loadKeyMaterial(keyName, newMaterial.internalKey, header.KeyVersion)
+			if err != nil {
+				return err
+			}
+			dataKey, err := oldMaterial.unwrapDataKey(header.WrappedKey)
+			if err != nil {
+				return err
+			}
+			header.KeyVersion = newMaterial.version.Version
+			if header.WrappedKey, err = newMaterial.wrapDataKey(dataKey); err != nil {
+				return err
+			}
+
+			headerBuffer, err := header.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			if _, err := destWriter.Write(headerBuffer); err != nil {
+				return err
+			}
+			_, err = io.Copy(destWriter, srcReader)
+			return err
+		}
+
+		// Re-encrypt blobs encrypted directly using the key's cipher
+		reader, header, err := newKeyDecryptReader(keyName, newMaterial.internalKey, fsFile, blobFile)
+		if err != nil {
+			return err
+		}
+		encWriter, err := newKeyEncryptWriter(newMaterial, header.Compressed, destWriter)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(encWriter, reader); err != nil {
+			return err
+		}
+		return encWriter.Close()
+	}(); err != nil {
+		destWriter.Close()
+		os.Remove(partialBlobPath)
+
+		// Authenticated legacy blobs encrypted with another key
+		if header == nil && errors.Is(err, entity.ErrIntegrity) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := destWriter.Close(); err != nil {
+		os.Remove(partialBlobPath)
+		return false, err
+	}
+	if err := os.Rename(partialBlobPath, blobPath); err != nil {
+		os.Remove(partialBlobPath)
+		return false, err
+	}
+
+	// Keep track of the new cipher & key
+	fsFile.CipherAlgorithm = newMaterial.internalKey.CipherAlgorithm
+	fsFile.KeyUid = newMaterial.internalKey.Uid
+	fsFile.KeyVersion = newMaterial.version.Version
+	fsFile.ModifiedAt_UnixTimestamp = uint64(time.Now().Unix())
+	return true, storage.Internal.UpdateStorage(fsFile.Path, *fsFile)
+}
+
+// Generates & saves the key's new version, used to encrypt from then on. The key must
+//  be locked & re-read by the caller. Returns the saved key along with its new version.
+func addKeyVersion(keyName string, internalKey storage.KeyStorage) (storage.KeyStorage, storage.KeyVersion, error) {
+	// Keep the same key size, recording it for keys generated prior to size selection
+	latestVersion := internalKey.LatestVersion()
+	if latestVersion == nil {
+		return internalKey, storage.KeyVersion{}, errors.New("key has no versions")
+	}
+	if internalKey.KeySize == 0 {
+		latestMaterial, err := loadKeyMaterial(keyName, internalKey, latestVersion.Version)
+		if err != nil {
+			return internalKey, storage.KeyVersion{}, err
+		}
+		internalKey.KeySize = latestMaterial.material.KeySize
+	}
+
+	keyVersion, err := generateKeyVersion(keyName, &internalKey, latestVersion.Version+1)
+	if err != nil {
+		utils.HandleErr(err, "[addKeyVersion]: failed to generate key version")
+		return internalKey, keyVersion, errors.New("internal error")
+	}
+	internalKey.Versions = append(internalKey.Versions, keyVersion)
+	internalKey.ModifiedAt_UnixTimestamp = uint64(time.Now().UnixMilli())
+	if err := entity.SaveKey(internalKey); err != nil {
+		log.Printf("[addKeyVersion]: Failed to save '%s' key: %v\n", keyName, err)
+		removeKeyVersionFiles(keyName, keyVersion.Version)
+		return internalKey, keyVersion, errors.New("internal error")
+	}
+	return internalKey, keyVersion, nil
+}
+
+// Constructs the key's rotation policy from the requested policy, nil if the key is
+//  never rotated automatically
+func keyRotationPolicy(policy *pb.EntityRotationPolicy) *storage.KeyRotationPolicy {
+	if policy == nil || (policy.EveryUnixTimestamp == 0 && policy.AfterEncryptions == 0) {
+		return nil
+	}
+	return &storage.KeyRotationPolicy{
+		Every_UnixTimestamp: policy.EveryUnixTimestamp,
+		AfterEncryptions:    policy.AfterEncryptions,
+	}
+}
+
+// Constructs the key's rotation policy response, nil if the key is never rotated
+//  automatically
+func keyRotationPolicyResponse(internalKey storage.KeyStorage) *pb.EntityRotationPolicy {
+	if internalKey.RotationPolicy == nil {
+		return nil
+	}
+	return &pb.EntityRotationPolicy{
+		EveryUnixTimestamp: internalKey.RotationPolicy.Every_UnixTimestamp,
+		AfterEncryptions:   internalKey.RotationPolicy.AfterEncryptions,
+	}
+}
+
+// Rotates the key by generating its new version, used to encrypt from then on, and
+//  moving every stored file encrypted with the key onto it. Files that fail to rotate
+//  remain decryptable using their older version.
+func (s openabyss_server) RotateKey(in *pb.KeyRotationRequest, stream pb.OpenAbyss_RotateKeyServer) error {
+	log.Printf("[RotateKey]: Rotating key '%s'\n", in.KeyId)
+
+	if !beginKeyRotation(in.KeyId) {
+		return errors.New("key is already being rotated")
+	}
+	defer endKeyRotation(in.KeyId)
+
+	// Generate & store the key's new version, the key only being locked meanwhile
+	internalKey, keyVersion, err := func() (storage.KeyStorage, storage.KeyVersion, error) {
+		unlockKey := lockKeys(in.KeyId)
+		defer unlockKey()
+
+		internalKey, ok := storage.Internal.GetKey(in.KeyId)
+		if !ok {
+			log.Printf("[RotateKey]: Key '%s' not found\n", in.KeyId)
+			return internalKey, storage.KeyVersion{}, errors.New("key id not found")
+		}
+		if err := checkKeyState(in.KeyId, internalKey, true); err != nil {
+			return internalKey, storage.KeyVersion{}, err
+		}
+		return addKeyVersion(in.KeyId, internalKey)
+	}()
+	if err != nil {
+		return err
+	}
+	log.Printf("[RotateKey]: Generated key '%s' version '%d'\n", in.KeyId, keyVersion.Version)
+
+	newMaterial, err := loadKeyMaterial(in.KeyId, internalKey, keyVersion.Version)
+	if err != nil {
+		return err
+	}
+
+	// Move stored files onto the new version
+	rotatedFiles := 0
+	allStorage := storage.Internal.GetAllStorage()
+	for idx, fsFile := range allStorage {
+		progress := &pb.KeyRotationProgress{
+			FilePath:  fsFile.Path,
+			Processed: uint64(idx + 1),
+			Total:     uint64(len(allStorage)),
+		}
+
+		if rotated, err := rotateFileBlob(in.KeyId, newMaterial, &fsFile); err != nil {
+			log.Printf("[RotateKey]: Failed to rotate '%s': %v\n", fsFile.Path, err)
+			progress.Error = "failed to rotate file"
+		} else if !rotated {
+			continue
+		} else {
+			rotatedFiles += 1
+		}
+
+		if err := stream.Send(progress); err != nil {
+			log.Printf("[RotateKey]: Failed to send progress, aborting: %v\n", err)
+			break
+		}
+	}
+
+	if _, err := storage.Internal.WriteToFile(); err != nil {
+		log.Printf("[RotateKey]: Failed to save internal storage: %v\n", err)
+	}
+	log.Printf("[RotateKey]: Rotated key '%s' to version '%d', %d stored files\n", in.KeyId, keyVersion.Version, rotatedFiles)
+	return nil
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/server/configuration"
+	"openabyss/server/storage"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPCs available while the server is sealed, every other RPC returns Unavailable
+var sealedMethods = map[string]bool{
+	"/server.OpenAbyss/Unseal":           true,
+	"/server.OpenAbyss/Seal":             true,
+	"/server.OpenAbyss/GetSealStatus":    true,
+	"/server.OpenAbyss/GetKeyAlgorithms": true,
+	"/server.OpenAbyss/GetServerVersion": true,
+}
+
+var (
+	sealMutex    sync.Mutex
+	unsealShares = map[byte][]byte{} // Submitted unseal shares by their x coordinate
+)
+
+// Whether the server is sealed, its master key not being held in memory
+func isSealed() bool {
+	return !entity.HasMasterKey()
+}
+
+// Returns Unavailable for RPCs requiring the master key while sealed
+func sealedUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isSealed() && !sealedMethods[info.FullMethod] {
+		return nil, status.Error(codes.Unavailable, "server is sealed")
+	}
+	return handler(ctx, req)
+}
+
+// Returns Unavailable for streamed RPCs requiring the master key while sealed
+func sealedStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isSealed() && !sealedMethods[info.FullMethod] {
+		return status.Error(codes.Unavailable, "server is sealed")
+	}
+	return handler(srv, ss)
+}
+
+// Constructs the server's seal status
+func sealStatusResponse() *pb.SealStatus {
+	sealStatus := &pb.SealStatus{
+		Sealed:   isSealed(),
+		Progress: uint32(len(unsealShares)),
+	}
+	if masterKeyStorage := storage.Internal.MasterKey; masterKeyStorage != nil && masterKeyStorage.Kdf == entity.MasterKeyKdf_Shamir {
+		sealStatus.Initialized = true
+		sealStatus.Shares = masterKeyStorage.Shares
+		sealStatus.Threshold = masterKeyStorage.Threshold
+	}
+	return sealStatus
+}
+
+// Discards submitted unseal shares
+func resetUnsealShares() {
+	for x, share := range unsealShares {
+		for idx := range share {
+			share[idx] = 0
+		}
+		delete(unsealShares, x)
+	}
+}
+
+// Splits the master key into unseal shares, any threshold number of them unsealing
+//  the server from then on. The master key file is removed, the passphrase no longer
+//  being used.
+func (s openabyss_server) Init(ctx context.Context, in *pb.InitRequest) (*pb.InitResponse, error) {
+	log.Printf("[Init]: Splitting master key into %d shares, threshold %d\n", in.Shares, in.Threshold)
+	sealMutex.Lock()
+	defer sealMutex.Unlock()
+
+	masterKeyStorage := storage.Internal.MasterKey
+	if masterKeyStorage == nil {
+		return nil, errors.New("master key not set up")
+	}
+	if masterKeyStorage.Kdf == entity.MasterKeyKdf_Shamir {
+		return nil, errors.New("server already initialized")
+	}
+
+	// Master key file is removed once split
+	keyFile := configuration.LoadedConfig.MasterKey.KeyFile
+	if masterKeyStorage.Kdf == entity.MasterKeyKdf_File {
+		if file, err := os.OpenFile(keyFile, os.O_WRONLY, 0); err != nil {
+			log.Printf("[Init]: Master key file '%s' not removable: %v\n", keyFile, err)
+			return nil, errors.New("master key file not removable")
+		} else {
+			file.Close()
+		}
+	}
+
+	shares, err := entity.SplitMasterKey(int(in.Shares), int(in.Threshold))
+	if err != nil {
+		log.Printf("[Init]: Failed to split master key: %v\n", err)
+		return nil, err
+	}
+
+	// Shares are only ever handed out, never stored
+	previousKdf := masterKeyStorage.Kdf
+	storage.Internal.MasterKey = &storage.MasterKeyStorage{
+		Kdf:                     entity.MasterKeyKdf_Shamir,
+		Shares:                  in.Shares,
+		Threshold:               in.Threshold,
+		Check:                   masterKeyStorage.Check,
+		CreatedAt_UnixTimestamp: masterKeyStorage.CreatedAt_UnixTimestamp,
+	}
+	if _, err := storage.Internal.WriteToFile(); err != nil {
+		storage.Internal.MasterKey = masterKeyStorage
+		log.Printf("[Init]: Failed to save internal storage: %v\n", err)
+		return nil, errors.New("internal error")
+	}
+	if masterKeyStorage.Kdf == entity.MasterKeyKdf_File {
+		if err := removeMasterKeyFile(keyFile); err != nil {
+			log.Printf("[Init]: Failed to remove master key file '%s', removed on next start: %v\n", keyFile, err)
+		} else {
+			log.Printf("[Init]: Removed master key file '%s'\n", keyFile)
+		}
+	}
+
+	encodedShares := make([]string, len(shares))
+	for idx, share := range shares {
+		encodedShares[idx] = base64.StdEncoding.EncodeToString(share)
+	}
+	log.Printf("[Init]: Master key split into %d shares, its '%s' source is no longer used\n", in.Shares, previousKdf)
+	return &pb.InitResponse{
+		Shares:    encodedShares,
+		Threshold: in.Threshold,
+	}, nil
+}
+
+// Submits an unseal share, unsealing the server once the threshold number of shares
+//  was submitted
+func (s openabyss_server) Unseal(ctx context.Context, in *pb.UnsealRequest) (*pb.SealStatus, error) {
+	sealMutex.Lock()
+	defer sealMutex.Unlock()
+
+	if in.ResetProgress {
+		log.Println("[Unseal]: Discarding submitted unseal shares")
+		resetUnsealShares()
+		if in.Share == "" {
+			return sealStatusResponse(), nil
+		}
+	}
+	if !isSealed() {
+		return sealStatusResponse(), nil
+	}
+
+	masterKeyStorage := storage.Internal.MasterKey
+	if masterKeyStorage == nil || masterKeyStorage.Kdf != entity.MasterKeyKdf_Shamir {
+		return nil, status.Error(codes.FailedPrecondition, "server not initialized with unseal shares")
+	}
+
+	share, err := base64.StdEncoding.DecodeString(in.Share)
+	if err != nil || len(share) != entity.DataKeySize+1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid unseal share")
+	}
+	unsealShares[share[len(share)-1]] = share
+	log.Printf("[Unseal]: Unseal share submitted, %d/%d\n", len(unsealShares), masterKeyStorage.Threshold)
+	if uint32(len(unsealShares)) < masterKeyStorage.Threshold {
+		return sealStatusResponse(), nil
+	}
+
+	// Recover & verify the master key, starting over if invalid
+	shares := make([][]byte, 0, len(unsealShares))
+	for _, share := range unsealShares {
+		shares = append(shares, share)
+	}
+	err = entity.UnsealMasterKey(shares, masterKeyStorage.Check)
+	resetUnsealShares()
+	if err != nil {
+		log.Printf("[Unseal]: Failed to unseal: %v\n", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid unseal shares, submitted shares were discarded")
+	}
+
+	if err := loadKeyStore(); err != nil {
+		log.Printf("[Unseal]: Failed to load key store: %v\n", err)
+		entity.ClearMasterKey()
+		return nil, status.Error(codes.Internal, "failed to load key store")
+	}
+	log.Println("[Unseal]: Server unsealed")
+	return sealStatusResponse(), nil
+}
+
+// Seals the server, wiping the master key & loaded keys from memory until unsealed
+func (s openabyss_server) Seal(ctx context.Context, in *pb.EmptyMessage) (*pb.SealStatus, error) {
+	sealMutex.Lock()
+	defer sealMutex.Unlock()
+
+	masterKeyStorage := storage.Internal.MasterKey
+	if masterKeyStorage == nil || masterKeyStorage.Kdf != entity.MasterKeyKdf_Shamir {
+		return nil, status.Error(codes.FailedPrecondition, "server not initialized with unseal shares")
+	}
+
+	entity.ClearMasterKey()
+	entity.Store.Clear()
+	clearTransportKey()
+	resetUnsealShares()
+	log.Println("[Seal]: Server sealed")
+	return sealStatusResponse(), nil
+}
+
+// Returns the server's seal status
+func (s openabyss_server) GetSealStatus(ctx context.Context, in *pb.EmptyMessage) (*pb.SealStatus, error) {
+	sealMutex.Lock()
+	defer sealMutex.Unlock()
+	return sealStatusResponse(), nil
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"archive/zip"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"io/ioutil"
@@ -72,7 +73,7 @@ func backup_current_storage(time_now_ms int64, storage_path string, backup_path
 			// Zip file with its data
 			f, _ := gw.Create(trimmedPrefix)
 			f.Write(data)
-		} else if d.Name() == BackupStoragePath {
+		} else if path == backup_path {
 			log.Printf("[backup_manager]: skipping backup storage directory %s\n", BackupStoragePath)
 			return filepath.SkipDir
 		}
@@ -138,3 +139,25 @@ func Init_Backup_Manager() {
 		}
 	}
 }
+
+// Reads the master key storage recorded within given backup file, nil for backups
+//  taken prior to a master key
+func ReadBackupMasterKey(backupFilepath string) (*MasterKeyStorage, error) {
+	r, err := zip.OpenReader(backupFilepath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := r.Open("internal.json")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var backupStorage FileStorageMap
+	if err := json.NewDecoder(f).Decode(&backupStorage); err != nil {
+		return nil, err
+	}
+	return backupStorage.MasterKey, nil
+}
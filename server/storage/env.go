@@ -29,20 +29,83 @@ type FileStorageMap struct {
 	CreatedAt_UnixTimestamp  uint64                    `json:"created_at_unix_timestamp"`
 	StorageMap               map[string]FileStorageMap `json:"sub_storage"`
 	Storage                  map[string]FileStorage    `json:"storage"`
-	KeyMap                   map[string]KeyStorage     `json:"keyStorage"`
+	KeyMap                   map[string]KeyStorage     `json:"keyStorage,omitempty"`      // Keys stored prior to the key store layout, loaded from the key store otherwise
+	MasterKey                *MasterKeyStorage         `json:"masterKey,omitempty"`       // Root storage only
+	KeyStoreVersion          uint32                    `json:"keyStoreVersion,omitempty"` // Root storage only, key store layout version, zero for flat key files
+}
+
+// MasterKeyStorage Structure describing the master key sealing key material at rest
+type MasterKeyStorage struct {
+	Kdf                     string `json:"kdf"`                     // Master key source, key file, passphrase derivation or unseal shares
+	Salt                    string `json:"salt,omitempty"`          // Base64 salt of passphrase derived master keys
+	Argon2Time              uint32 `json:"argon2Time,omitempty"`    // Argon2id passes of passphrase derived master keys
+	Argon2Memory            uint32 `json:"argon2Memory,omitempty"`  // Argon2id memory in KiB of passphrase derived master keys
+	Argon2Threads           uint8  `json:"argon2Threads,omitempty"` // Argon2id threads of passphrase derived master keys
+	Shares                  uint32 `json:"shares,omitempty"`        // Number of unseal shares the master key was split into
+	Threshold               uint32 `json:"threshold,omitempty"`     // Number of unseal shares required to unseal
+	Check                   string `json:"check"`                   // Known value sealed using the master key, verifying it
+	CreatedAt_UnixTimestamp uint64 `json:"created_at_unix_timestamp"`
+}
+
+// Key Version State "Enum" Mapping
+const (
+	KeyVersion_Enabled   = "enabled"   // Encrypts if latest, otherwise decrypt-only
+	KeyVersion_Disabled  = "disabled"  // Unable to encrypt or decrypt until re-enabled
+	KeyVersion_Destroyed = "destroyed" // Key material removed, unable to be used again
+)
+
+// Key State "Enum" Mapping
+const (
+	KeyState_Active      = "active"       // Encrypts & decrypts
+	KeyState_DecryptOnly = "decrypt-only" // Decrypts files, refusing new encryptions
+	KeyState_Pending     = "pending"      // Prior to its not-before time, unable to encrypt or decrypt
+	KeyState_Expired     = "expired"      // Past its expiry time, decrypts files refusing new encryptions
+	KeyState_Disabled    = "disabled"     // Unable to encrypt or decrypt until re-enabled
+	KeyState_Destroyed   = "destroyed"    // Key material of every version removed, metadata kept
+)
+
+// KeyStateTransition Structure recording each change of a Key's lifecycle state
+type KeyStateTransition struct {
+	State                   string `json:"state"`
+	ChangedAt_UnixTimestamp uint64 `json:"changed_at_unix_timestamp"`
+}
+
+// KeyVersion Structure for each generation of a Key's material
+type KeyVersion struct {
+	Version                 uint32 `json:"version"`
+	CipherEncKey            string `json:"cipherEncKey"`
+	PrivateKey_pem          string `json:"privateKey,omitempty"` // PKCS#8 private key of ECDH keys
+	PublicKey_pem           string `json:"publicKey,omitempty"`  // PKIX public key of ECDH keys
+	State                   string `json:"state"`
+	CreatedAt_UnixTimestamp uint64 `json:"created_at_unix_timestamp"`
+	Encryptions             uint64 `json:"encryptions,omitempty"` // Files encrypted using the version
+}
+
+// KeyRotationPolicy Structure for automatically rotating a Key onto a new version
+type KeyRotationPolicy struct {
+	Every_UnixTimestamp uint64 `json:"every_unix_timestamp,omitempty"` // Rotates once the latest version is older, in Milliseconds
+	AfterEncryptions    uint64 `json:"afterEncryptions,omitempty"`     // Rotates once the latest version encrypted as many files
 }
 
 // KeyStorage Structure for each Key
 type KeyStorage struct {
-	Name                     string `json:"name"`
-	Description              string `json:"description"`
-	Algorithm                string `json:"algorithm"`
-	CipherEncKey             string `json:"cipherEncKey"`
-	CipherAlgorithm          string `json:"cipherAlgorithm"`
-	SigningPublicKey_pem     string `json:"sigingPublickKey"`
-	ExpiresAt_UnixTimestamp  uint64 `json:"expires_at_unix_timestamp"` // Expires the abilit to encrypt data, can still decrypt (becomes read-only)
-	CreatedAt_UnixTimestamp  uint64 `json:"created_at_unix_timestamp"`
-	ModifiedAt_UnixTimestamp uint64 `json:"modified_at_unix_timestamp"`
+	Uid                      string               `json:"uid"` // Unique identifier, unchanged when renamed
+	Name                     string               `json:"name"`
+	Description              string               `json:"description"`
+	Algorithm                string               `json:"algorithm"`
+	CipherEncKey             string               `json:"cipherEncKey,omitempty"` // Keys stored prior to versions, moved into Versions once loaded
+	Versions                 []KeyVersion         `json:"versions"`               // Ordered from oldest to latest
+	CipherAlgorithm          string               `json:"cipherAlgorithm"`
+	KeySize                  int                  `json:"keySize,omitempty"`  // RSA key size in bits, unknown for keys generated prior to size selection
+	OAEPHash                 string               `json:"oaepHash,omitempty"` // RSA-OAEP hash, empty for keys generated prior to hash selection (sha256)
+	SigningPublicKey_pem     string               `json:"sigingPublickKey"`
+	ExpiresAt_UnixTimestamp  uint64               `json:"expires_at_unix_timestamp"`           // Expires the abilit to encrypt data, can still decrypt (becomes read-only)
+	NotBefore_UnixTimestamp  uint64               `json:"not_before_unix_timestamp,omitempty"` // Activates the key, unable to be used prior to it. Zero if active once generated
+	CreatedAt_UnixTimestamp  uint64               `json:"created_at_unix_timestamp"`
+	ModifiedAt_UnixTimestamp uint64               `json:"modified_at_unix_timestamp"`
+	State                    string               `json:"state,omitempty"`            // Lifecycle state, empty for keys stored prior to lifecycle states (active)
+	StateTransitions         []KeyStateTransition `json:"stateTransitions,omitempty"` // Ordered from oldest to latest
+	RotationPolicy           *KeyRotationPolicy   `json:"rotationPolicy,omitempty"`   // Never rotated automatically if unset
 }
 
 // FileStorage Structure for each Entry
@@ -51,6 +114,9 @@ type FileStorage struct {
 	Name                     string `json:"name"`
 	SizeInBytes              uint64 `json:"sizeInBytes"`
 	Type                     uint8  `json:"type"`
+	CipherAlgorithm          string `json:"cipherAlgorithm"` // Empty for files encrypted prior to cipher tracking (aes)
+	KeyUid                   string `json:"keyUid"`          // Unique identifier of the key that encrypted the file, empty if unknown
+	KeyVersion               uint32 `json:"keyVersion"`      // Version of the key that encrypted the file, zero for files encrypted prior to versions (1)
 	CreatedAt_UnixTimestamp  uint64 `json:"created_at_unix_timestamp"`
 	ModifiedAt_UnixTimestamp uint64 `json:"modified_at_unix_timestamp"`
 }
@@ -27,6 +27,19 @@ func Init() {
 			log.Fatalln("internal storage unmarshal error:", err)
 		}
 
+		// Assign identifiers to keys stored prior to having one, and versions to
+		//  keys stored prior to versions
+		for name, entry := range Internal.KeyMap {
+			if entry.Uid == "" {
+				entry.Uid = GenerateKeyUid()
+				log.Printf("[storage]: assigned key '%s' uid '%s'\n", name, entry.Uid)
+			}
+			if entry.MigrateLegacyVersion() {
+				log.Printf("[storage]: moved key '%s' cipher into version 1\n", name)
+			}
+			Internal.KeyMap[name] = entry
+		}
+
 	} else {
 		// Create Storage directory
 		log.Println("[storage]: no internal persistant file found")
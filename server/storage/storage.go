@@ -1,14 +1,21 @@
 package storage
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"path"
 	"strings"
+	"sync"
 	"time"
 )
 
+// Guards the key map, shared by RPCs & the key scheduler. Only held while accessing
+//  the map, never across file I/O.
+var keyMapMutex sync.RWMutex
+
 // Internal helper function for creating sub-storages
 func (fsMap *FileStorageMap) create_sub_storage(sub_storage string) error {
 	// New storage map if none exist
@@ -90,6 +97,21 @@ func (fsMap *FileStorageMap) Store(fileId string, filePath string, fileByteSize
 	return &fsFile, nil
 }
 
+// Handles replacing an existing storage entry at given file path with the given
+//  entry's data
+func (fsMap *FileStorageMap) UpdateStorage(filePath string, entry FileStorage) error {
+	fsMap, err := fsMap.GetSubStorageByPath(path.Dir(filePath))
+	if err != nil {
+		return err
+	}
+
+	if _, ok := fsMap.Storage[path.Base(filePath)]; !ok {
+		return errors.New("storage entry not found")
+	}
+	fsMap.Storage[path.Base(filePath)] = entry
+	return nil
+}
+
 // Handles removing storage entry retuning the actual file path storage if successful
 func (fsMap *FileStorageMap) RemoveStorage(ssPath string) (string, error) {
 	// Obtain Sub Storage by path
@@ -152,10 +174,226 @@ func (fsMap *FileStorageMap) GetFileByPath(filePath string) (*FileStorage, error
 	}
 }
 
+// Returns all file storage entries within the map and its sub-storages
+func (fsMap *FileStorageMap) GetAllStorage() []FileStorage {
+	entries := []FileStorage{}
+
+	// Root directory keys (BFS Algorithm)
+	dirQueue := []*FileStorageMap{fsMap}
+	for ; len(dirQueue) != 0; dirQueue = dirQueue[1:] {
+		fsSubStorage := dirQueue[0]
+
+		for name := range fsSubStorage.StorageMap {
+			dirQueue = append(dirQueue, fsSubStorage.GetSubStorage(name))
+		}
+		for _, entry := range fsSubStorage.Storage {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// Returns all file storage entries recorded as encrypted by the given unique key identifier
+func (fsMap *FileStorageMap) GetStorageByKeyUid(uid string) []FileStorage {
+	entries := []FileStorage{}
+	if uid == "" {
+		return entries
+	}
+
+	for _, entry := range fsMap.GetAllStorage() {
+		if entry.KeyUid == uid {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Generates a new random unique key identifier
+func GenerateKeyUid() string {
+	uid := make([]byte, 16)
+	rand.Read(uid)
+	return hex.EncodeToString(uid)
+}
+
+// Returns the key entry of given name
+func (fsMap *FileStorageMap) GetKey(keyName string) (KeyStorage, bool) {
+	keyMapMutex.RLock()
+	defer keyMapMutex.RUnlock()
+	entry, ok := fsMap.KeyMap[keyName]
+	return entry, ok
+}
+
+// Returns a copy of the key entries by name
+func (fsMap *FileStorageMap) GetKeys() map[string]KeyStorage {
+	keyMapMutex.RLock()
+	defer keyMapMutex.RUnlock()
+	entries := make(map[string]KeyStorage, len(fsMap.KeyMap))
+	for name, entry := range fsMap.KeyMap {
+		entries[name] = entry
+	}
+	return entries
+}
+
+// Returns the number of key entries
+func (fsMap *FileStorageMap) KeyCount() int {
+	keyMapMutex.RLock()
+	defer keyMapMutex.RUnlock()
+	return len(fsMap.KeyMap)
+}
+
+// Sets the key entry of given name
+func (fsMap *FileStorageMap) SetKey(keyName string, entry KeyStorage) {
+	keyMapMutex.Lock()
+	defer keyMapMutex.Unlock()
+	fsMap.KeyMap[keyName] = entry
+}
+
+// Removes the key entry of given name
+func (fsMap *FileStorageMap) DeleteKey(keyName string) {
+	keyMapMutex.Lock()
+	defer keyMapMutex.Unlock()
+	delete(fsMap.KeyMap, keyName)
+}
+
+// Removes every key entry
+func (fsMap *FileStorageMap) ClearKeys() {
+	keyMapMutex.Lock()
+	defer keyMapMutex.Unlock()
+	fsMap.KeyMap = make(map[string]KeyStorage)
+}
+
+// Counts a file encrypted using the key entry's version, in memory only. Returns
+//  false if the key or its version is missing.
+func (fsMap *FileStorageMap) CountKeyVersionEncryption(keyName string, version uint32) bool {
+	keyMapMutex.Lock()
+	defer keyMapMutex.Unlock()
+	entry, ok := fsMap.KeyMap[keyName]
+	if !ok {
+		return false
+	}
+
+	// Versions are shared with copies of the entry, so count within a copy
+	entry.Versions = append([]KeyVersion(nil), entry.Versions...)
+	keyVersion := entry.GetVersion(version)
+	if keyVersion == nil {
+		return false
+	}
+	keyVersion.Encryptions += 1
+	fsMap.KeyMap[keyName] = entry
+	return true
+}
+
+// Returns the key entry's name matching given unique key identifier
+func (fsMap *FileStorageMap) GetKeyNameByUid(uid string) (string, bool) {
+	keyMapMutex.RLock()
+	defer keyMapMutex.RUnlock()
+	for name, entry := range fsMap.KeyMap {
+		if entry.Uid == uid {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Moves the key's cipher key, stored prior to versions, into its first version.
+//  Returns whether the entry was modified.
+func (key *KeyStorage) MigrateLegacyVersion() bool {
+	if len(key.Versions) > 0 {
+		return false
+	}
+
+	key.Versions = []KeyVersion{{
+		Version:                 1,
+		CipherEncKey:            key.CipherEncKey,
+		State:                   KeyVersion_Enabled,
+		CreatedAt_UnixTimestamp: key.CreatedAt_UnixTimestamp,
+	}}
+	key.CipherEncKey = ""
+	return true
+}
+
+// Returns the key's given version
+func (key *KeyStorage) GetVersion(version uint32) *KeyVersion {
+	for idx := range key.Versions {
+		if key.Versions[idx].Version == version {
+			return &key.Versions[idx]
+		}
+	}
+	return nil
+}
+
+// Returns the key's latest version, used for encrypting
+func (key *KeyStorage) LatestVersion() *KeyVersion {
+	if len(key.Versions) == 0 {
+		return nil
+	}
+	return &key.Versions[len(key.Versions)-1]
+}
+
+// Returns the key's lifecycle state, keys stored prior to lifecycle states being active
+func (key *KeyStorage) GetState() string {
+	if key.State == "" {
+		return KeyState_Active
+	}
+	return key.State
+}
+
+// Returns whether the key's rotation policy is due at the given time, based on the
+//  age & encryptions of its latest version
+func (key *KeyStorage) RotationDue(now uint64) bool {
+	latestVersion := key.LatestVersion()
+	if key.RotationPolicy == nil || latestVersion == nil {
+		return false
+	}
+	if every := key.RotationPolicy.Every_UnixTimestamp; every != 0 && now >= latestVersion.CreatedAt_UnixTimestamp+every {
+		return true
+	}
+	if after := key.RotationPolicy.AfterEncryptions; after != 0 && latestVersion.Encryptions >= after {
+		return true
+	}
+	return false
+}
+
+// Computes the key's status at the given time. Active, pending & expired keys follow
+//  their not-before & expiry times, other lifecycle states being kept as is.
+func (key *KeyStorage) GetStatus(now uint64) string {
+	state := key.GetState()
+	if state != KeyState_Active && state != KeyState_Pending && state != KeyState_Expired {
+		return state
+	}
+	if key.NotBefore_UnixTimestamp != 0 && now < key.NotBefore_UnixTimestamp {
+		return KeyState_Pending
+	}
+	if key.ExpiresAt_UnixTimestamp != 0 && now >= key.ExpiresAt_UnixTimestamp {
+		return KeyState_Expired
+	}
+	return KeyState_Active
+}
+
+// Moves the key into the given lifecycle state, recording the transition
+func (key *KeyStorage) SetState(state string) {
+	now := uint64(time.Now().UnixMilli())
+	key.State = state
+	key.StateTransitions = append(key.StateTransitions, KeyStateTransition{
+		State:                   state,
+		ChangedAt_UnixTimestamp: now,
+	})
+	key.ModifiedAt_UnixTimestamp = now
+}
+
 // Writes internal data to file
 func (fsMap *FileStorageMap) WriteToFile() (int, error) {
+	// Keys are saved within the key store's manifests once migrated
+	keyMapMutex.RLock()
+	persisted := Internal
+	if persisted.KeyStoreVersion > 0 {
+		persisted.KeyMap = nil
+	}
+	data, _ := json.Marshal(persisted)
+	keyMapMutex.RUnlock()
+
 	// Open & Save data
-	data, _ := json.Marshal(Internal)
 	if err := ioutil.WriteFile(InternalConfigPath, data, 0644); err != nil {
 		return 0, err
 	}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdh"
+	"errors"
+	"log"
+	"openabyss/entity"
+	pb "openabyss/proto/server"
+	"openabyss/utils"
+	"sync"
+)
+
+var (
+	transportMutex sync.Mutex
+	transportKey   *ecdh.PrivateKey // Only ever held in memory, regenerated once sealed or restarted
+)
+
+// Returns the server's transport key, generating it if missing
+func getTransportKey() (*ecdh.PrivateKey, error) {
+	transportMutex.Lock()
+	defer transportMutex.Unlock()
+
+	if transportKey == nil {
+		sk, err := entity.GenerateTransportKey()
+		if err != nil {
+			return nil, err
+		}
+		transportKey = sk
+		log.Println("[getTransportKey]: Generated transport key")
+	}
+	return transportKey, nil
+}
+
+// Discards the server's transport key, packages sealed for it can no longer be imported
+func clearTransportKey() {
+	transportMutex.Lock()
+	defer transportMutex.Unlock()
+	transportKey = nil
+}
+
+// Returns the server's transport public key, which other servers seal exported keys
+//  for, only this server being able to import them
+func (s openabyss_server) GetTransportKey(ctx context.Context, in *pb.EmptyMessage) (*pb.TransportKey, error) {
+	sk, err := getTransportKey()
+	if err != nil {
+		utils.HandleErr(err, "[GetTransportKey]: failed to generate transport key")
+		return nil, errors.New("internal error")
+	}
+	pkPem, err := entity.MarshalECDHPublicKey(sk.PublicKey())
+	if err != nil {
+		utils.HandleErr(err, "[GetTransportKey]: failed to encode transport key")
+		return nil, errors.New("internal error")
+	}
+
+	return &pb.TransportKey{
+		PublicKey: pkPem,
+		Algorithm: entity.ECDH_X25519,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package entity_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function that creates a random AES-256 key
+func newTestAESKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.Nil(t, err, "failed to generate key")
+	return key
+}
+
+// Buffer closed by the encryption writer once done
+type bytesWriteCloser struct {
+	*bytes.Buffer
+}
+
+func (bytesWriteCloser) Close() error {
+	return nil
+}
+
+// Helper function that encrypts given data using AES-256-GCM in sealed chunks,
+//  returning the ciphertext and its nonce prefix
+func aeadEncrypt(t *testing.T, key []byte, data []byte) ([]byte, []byte) {
+	algorithm, err := entity.GetCipherAlgorithm(entity.CipherAES_GCM)
+	assert.Nil(t, err, "failed to get cipher algorithm")
+
+	encBuffer := &bytesWriteCloser{Buffer: bytes.NewBuffer(nil)}
+	prefix := make([]byte, algorithm.NonceSize())
+	rand.Read(prefix)
+
+	writer, err := algorithm.NewEncryptWriter(encBuffer, key, prefix, nil)
+	assert.Nil(t, err, "failed to create encryption writer")
+	_, err = writer.Write(data)
+	assert.Nil(t, err, "failed to write data")
+	assert.Nil(t, writer.Close(), "failed to close encryption writer")
+	return encBuffer.Bytes(), prefix
+}
+
+// Helper function that decrypts given AES-256-GCM sealed chunks
+func aeadDecrypt(t *testing.T, key []byte, cipherText []byte, prefix []byte) ([]byte, error) {
+	algorithm, err := entity.GetCipherAlgorithm(entity.CipherAES_GCM)
+	assert.Nil(t, err, "failed to get cipher algorithm")
+
+	reader, err := algorithm.NewDecryptReader(bytes.NewReader(cipherText), key, prefix, nil)
+	assert.Nil(t, err, "failed to create decryption reader")
+	return io.ReadAll(reader)
+}
+
+func TestAEAD_EncryptDecrypt_MultipleChunks_Success(t *testing.T) {
+	key := newTestAESKey(t)
+
+	// Sizes around chunk boundaries
+	for _, size := range []int{0, 1, entity.AEADChunkSize, entity.AEADChunkSize + 1, 3*entity.AEADChunkSize - 7} {
+		data := make([]byte, size)
+		rand.Read(data)
+
+		cipherText, prefix := aeadEncrypt(t, key, data)
+		plainText, err := aeadDecrypt(t, key, cipherText, prefix)
+		assert.Nil(t, err, "failed to decrypt data of size %d", size)
+		assert.Equal(t, data, plainText, "decrypted data mismatch for size %d", size)
+	}
+}
+
+func TestAEAD_Decrypt_TamperedData_Failure(t *testing.T) {
+	key := newTestAESKey(t)
+	data := make([]byte, 2*entity.AEADChunkSize)
+	rand.Read(data)
+
+	// Flip a bit within the ciphertext
+	cipherText, prefix := aeadEncrypt(t, key, data)
+	cipherText[len(cipherText)/2] ^= 1
+
+	_, err := aeadDecrypt(t, key, cipherText, prefix)
+	assert.ErrorIs(t, err, entity.ErrIntegrity, "tampered data did not fail integrity check")
+}
+
+func TestAEAD_Decrypt_TruncatedData_Failure(t *testing.T) {
+	key := newTestAESKey(t)
+	data := make([]byte, 2*entity.AEADChunkSize+10)
+	rand.Read(data)
+
+	// Drop the last chunk entirely
+	cipherText, prefix := aeadEncrypt(t, key, data)
+	truncatedSize := len(cipherText) - (10 + 16) // GCM tag size
+
+	_, err := aeadDecrypt(t, key, cipherText[:truncatedSize], prefix)
+	assert.ErrorIs(t, err, entity.ErrIntegrity, "truncated data did not fail integrity check")
+}
@@ -0,0 +1,46 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlgorithm_GenerateWrapUnwrap_Success(t *testing.T) {
+	entity.KeyStorePath = t.TempDir()
+	dataKey, _ := entity.GenerateDataKey()
+
+	for _, algorithm := range entity.KeyAlgorithms() {
+		// Parameters unused by the algorithm are cleared on generation
+		material := &entity.KeyMaterial{
+			StoreName: "test-" + algorithm.Name(),
+			KeySize:   2048,
+			OAEPHash:  entity.DefaultOAEPHash,
+		}
+		if !assert.Nil(t, algorithm.Generate(material), "failed to generate '%s' key", algorithm.Name()) {
+			continue
+		}
+		assert.Nil(t, algorithm.Load(material), "failed to load '%s' key", algorithm.Name())
+
+		wrappedKey, err := algorithm.WrapDataKey(material, dataKey)
+		assert.Nil(t, err, "failed to wrap data key using '%s'", algorithm.Name())
+
+		unwrappedKey, err := algorithm.UnwrapDataKey(material, wrappedKey)
+		assert.Nil(t, err, "failed to unwrap data key using '%s'", algorithm.Name())
+		assert.Equal(t, dataKey, unwrappedKey)
+	}
+}
+
+func TestAlgorithm_GetKeyAlgorithm_Unknown_Failure(t *testing.T) {
+	_, err := entity.GetKeyAlgorithm("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestAlgorithm_GetSelectableCipher_Unauthenticated_Failure(t *testing.T) {
+	_, err := entity.GetSelectableCipherAlgorithm(entity.CipherAES_CFB)
+	assert.NotNil(t, err)
+
+	_, err = entity.GetSelectableCipherAlgorithm(entity.CipherXChaCha20_Poly1305)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,135 @@
+package entity_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function that creates a random AES-256 cipher block
+func newTestBlock(t *testing.T) cipher.Block {
+	key := make([]byte, 32)
+	rand.Read(key)
+	c, err := aes.NewCipher(key)
+	assert.Nil(t, err, "failed to create cipher")
+	return c
+}
+
+// Helper function that creates a random 256 bit data key
+func newTestKey(t *testing.T) []byte {
+	key, err := entity.GenerateDataKey()
+	assert.Nil(t, err, "failed to generate key")
+	return key
+}
+
+// Helper function that writes given data into a blob using given header
+func blobEncrypt(t *testing.T, key []byte, header *entity.BlobHeader, data []byte) []byte {
+	encBuffer := bytes.NewBuffer(nil)
+	writer, err := entity.NewBlobWriter(encBuffer, key, header)
+	assert.Nil(t, err, "failed to create blob writer")
+
+	_, err = writer.Write(data)
+	assert.Nil(t, err, "failed to write data")
+	assert.Nil(t, writer.Close(), "failed to close blob writer")
+	return encBuffer.Bytes()
+}
+
+// Helper function that reads the header & decrypts given blob
+func blobDecrypt(key []byte, blob []byte) (*entity.BlobHeader, []byte, error) {
+	srcReader := bufio.NewReader(bytes.NewReader(blob))
+	header, err := entity.ReadBlobHeader(srcReader)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := entity.NewBlobReader(srcReader, key, header)
+	if err != nil {
+		return header, nil, err
+	}
+	plainText, err := io.ReadAll(reader)
+	return header, plainText, err
+}
+
+func TestBlob_EncryptDecrypt_Ciphers_Success(t *testing.T) {
+	key := newTestKey(t)
+	data := make([]byte, 2*entity.AEADChunkSize+13)
+	rand.Read(data)
+
+	for _, cipherAlgorithm := range []string{entity.CipherAES_CFB, entity.CipherAES_GCM, entity.CipherXChaCha20_Poly1305} {
+		blob := blobEncrypt(t, key, &entity.BlobHeader{
+			CipherAlgorithm: cipherAlgorithm,
+			Compressed:      true,
+			KeyId:           "uid",
+			KeyVersion:      3,
+			WrappedKey:      []byte("wrapped"),
+		}, data)
+
+		header, plainText, err := blobDecrypt(key, blob)
+		assert.Nil(t, err, "failed to decrypt blob")
+		assert.Equal(t, data, plainText, "decrypted data mismatch")
+		assert.Equal(t, entity.BlobFormatVersion, header.Version)
+		assert.Equal(t, cipherAlgorithm, header.CipherAlgorithm)
+		assert.True(t, header.Compressed)
+		assert.Equal(t, "uid", header.KeyId)
+		assert.Equal(t, uint32(3), header.KeyVersion)
+		assert.Equal(t, []byte("wrapped"), header.WrappedKey)
+	}
+}
+
+func TestBlob_ReadHeader_Legacy_Failure(t *testing.T) {
+	legacyBlob := []byte("bGVnYWN5IGJhc2U2NCBibG9i")
+	srcReader := bufio.NewReader(bytes.NewReader(legacyBlob))
+
+	_, err := entity.ReadBlobHeader(srcReader)
+	assert.Equal(t, entity.ErrLegacyBlob, err)
+
+	// Legacy blob is left unconsumed
+	remaining, _ := io.ReadAll(srcReader)
+	assert.Equal(t, legacyBlob, remaining)
+}
+
+func TestBlob_Decrypt_TamperedHeader_Failure(t *testing.T) {
+	key := newTestKey(t)
+	blob := blobEncrypt(t, key, &entity.BlobHeader{
+		CipherAlgorithm: entity.CipherAES_GCM,
+		KeyId:           "uid",
+		KeyVersion:      1,
+	}, []byte("some data"))
+
+	// Flip compression flag
+	blob[6] ^= entity.BlobFlag_Compressed
+
+	_, _, err := blobDecrypt(key, blob)
+	assert.Equal(t, entity.ErrIntegrity, err)
+}
+
+func TestBlob_Decrypt_RewrappedHeader_Success(t *testing.T) {
+	key := newTestKey(t)
+	blob := blobEncrypt(t, key, &entity.BlobHeader{
+		CipherAlgorithm: entity.CipherAES_GCM,
+		KeyId:           "uid",
+		KeyVersion:      1,
+		WrappedKey:      []byte("wrapped"),
+	}, []byte("some data"))
+
+	// Replace the header's data key wrapping fields, keeping the ciphertext
+	srcReader := bufio.NewReader(bytes.NewReader(blob))
+	header, err := entity.ReadBlobHeader(srcReader)
+	assert.Nil(t, err, "failed to read blob header")
+	header.KeyVersion = 2
+	header.WrappedKey = []byte("re-wrapped")
+	headerBuffer, err := header.MarshalBinary()
+	assert.Nil(t, err, "failed to marshal blob header")
+	cipherText, _ := io.ReadAll(srcReader)
+
+	header, plainText, err := blobDecrypt(key, append(headerBuffer, cipherText...))
+	assert.Nil(t, err, "failed to decrypt re-wrapped blob")
+	assert.Equal(t, []byte("some data"), plainText)
+	assert.Equal(t, []byte("re-wrapped"), header.WrappedKey)
+}
@@ -0,0 +1,48 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestECDH_WrapUnwrap_Success(t *testing.T) {
+	dataKey, _ := entity.GenerateDataKey()
+
+	for _, curve := range []string{entity.ECDH_X25519, entity.ECDH_P256} {
+		sk, err := entity.GenerateECDHKey(curve)
+		assert.Nil(t, err, "failed to generate '%s' key", curve)
+
+		// Keys are persisted as PEM
+		skPem, err := entity.MarshalECDHPrivateKey(sk)
+		assert.Nil(t, err, "failed to marshal '%s' key", curve)
+		parsedSk, err := entity.ParseECDHPrivateKey(curve, skPem)
+		assert.Nil(t, err, "failed to parse '%s' key", curve)
+		assert.True(t, sk.Equal(parsedSk), "parsed '%s' key mismatch", curve)
+
+		wrappedKey, err := entity.ECDHWrapDataKey(sk.PublicKey(), dataKey)
+		assert.Nil(t, err, "failed to wrap data key using '%s'", curve)
+
+		unwrappedKey, err := entity.ECDHUnwrapDataKey(parsedSk, wrappedKey)
+		assert.Nil(t, err, "failed to unwrap data key using '%s'", curve)
+		assert.Equal(t, dataKey, unwrappedKey)
+	}
+}
+
+func TestECDH_Unwrap_WrongKey_Failure(t *testing.T) {
+	dataKey, _ := entity.GenerateDataKey()
+	sk, _ := entity.GenerateECDHKey(entity.ECDH_X25519)
+	otherSk, _ := entity.GenerateECDHKey(entity.ECDH_X25519)
+
+	wrappedKey, err := entity.ECDHWrapDataKey(sk.PublicKey(), dataKey)
+	assert.Nil(t, err, "failed to wrap data key")
+
+	_, err = entity.ECDHUnwrapDataKey(otherSk, wrappedKey)
+	assert.Equal(t, entity.ErrIntegrity, err)
+
+	// Keys of another curve are rejected
+	skPem, _ := entity.MarshalECDHPrivateKey(sk)
+	_, err = entity.ParseECDHPrivateKey(entity.ECDH_P256, skPem)
+	assert.NotNil(t, err, "parsed key of another curve")
+}
@@ -0,0 +1,65 @@
+package entity_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope_CipherSealOpen_Success(t *testing.T) {
+	c := newTestBlock(t)
+	dataKey, err := entity.GenerateDataKey()
+	assert.Nil(t, err, "failed to generate data key")
+	assert.Len(t, dataKey, entity.DataKeySize)
+
+	wrappedKey, err := entity.CipherSeal(c, dataKey)
+	assert.Nil(t, err, "failed to wrap data key")
+	assert.NotContains(t, string(wrappedKey), string(dataKey))
+
+	unwrappedKey, err := entity.CipherOpen(c, wrappedKey)
+	assert.Nil(t, err, "failed to unwrap data key")
+	assert.Equal(t, dataKey, unwrappedKey)
+}
+
+func TestEnvelope_CipherOpen_WrongKey_Failure(t *testing.T) {
+	dataKey, _ := entity.GenerateDataKey()
+	wrappedKey, err := entity.CipherSeal(newTestBlock(t), dataKey)
+	assert.Nil(t, err, "failed to wrap data key")
+
+	_, err = entity.CipherOpen(newTestBlock(t), wrappedKey)
+	assert.Equal(t, entity.ErrIntegrity, err)
+}
+
+func TestEnvelope_RSAWrapUnwrap_OAEPHash_Success(t *testing.T) {
+	sk, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err, "failed to generate rsa key")
+	e := &entity.Entity{PrivateKey: sk, PublicKey: &sk.PublicKey}
+	dataKey, _ := entity.GenerateDataKey()
+
+	for _, oaepHash := range []string{"", entity.OAEPHash_SHA256, entity.OAEPHash_SHA384, entity.OAEPHash_SHA512} {
+		wrappedKey, err := entity.RSAWrapDataKey(e, dataKey, oaepHash)
+		assert.Nil(t, err, "failed to wrap data key using '%s'", oaepHash)
+
+		unwrappedKey, err := entity.RSAUnwrapDataKey(e, wrappedKey, oaepHash)
+		assert.Nil(t, err, "failed to unwrap data key using '%s'", oaepHash)
+		assert.Equal(t, dataKey, unwrappedKey)
+	}
+}
+
+func TestEnvelope_RSAUnwrap_OAEPHashMismatch_Failure(t *testing.T) {
+	sk, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err, "failed to generate rsa key")
+	e := &entity.Entity{PrivateKey: sk, PublicKey: &sk.PublicKey}
+	dataKey, _ := entity.GenerateDataKey()
+
+	wrappedKey, err := entity.RSAWrapDataKey(e, dataKey, entity.OAEPHash_SHA512)
+	assert.Nil(t, err, "failed to wrap data key")
+
+	_, err = entity.RSAUnwrapDataKey(e, wrappedKey, entity.OAEPHash_SHA256)
+	assert.NotNil(t, err, "unwrapped data key using another oaep hash")
+	assert.NotNil(t, entity.ValidateOAEPHash("md5"), "unsupported oaep hash validated")
+	assert.NotNil(t, entity.ValidateRSAKeySize(1024), "unsupported rsa key size validated")
+}
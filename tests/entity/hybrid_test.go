@@ -0,0 +1,43 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHybrid_WrapUnwrap_Success(t *testing.T) {
+	dataKey, _ := entity.GenerateDataKey()
+	sk, err := entity.GenerateHybridKey()
+	assert.Nil(t, err, "failed to generate hybrid key")
+
+	// Keys are persisted as PEM
+	parsedSk, err := entity.ParseHybridPrivateKey(entity.MarshalHybridPrivateKey(sk))
+	assert.Nil(t, err, "failed to parse hybrid key")
+	assert.Equal(t, sk.PublicKey().Bytes(), parsedSk.PublicKey().Bytes(), "parsed hybrid key mismatch")
+
+	wrappedKey, err := entity.HybridWrapDataKey(sk.PublicKey(), dataKey)
+	assert.Nil(t, err, "failed to wrap data key")
+
+	unwrappedKey, err := entity.HybridUnwrapDataKey(parsedSk, wrappedKey)
+	assert.Nil(t, err, "failed to unwrap data key")
+	assert.Equal(t, dataKey, unwrappedKey)
+}
+
+func TestHybrid_Unwrap_Tampered_Failure(t *testing.T) {
+	dataKey, _ := entity.GenerateDataKey()
+	sk, _ := entity.GenerateHybridKey()
+	otherSk, _ := entity.GenerateHybridKey()
+
+	wrappedKey, err := entity.HybridWrapDataKey(sk.PublicKey(), dataKey)
+	assert.Nil(t, err, "failed to wrap data key")
+
+	_, err = entity.HybridUnwrapDataKey(otherSk, wrappedKey)
+	assert.Equal(t, entity.ErrIntegrity, err)
+
+	// Tampering with the ML-KEM ciphertext
+	wrappedKey[0] ^= 0xff
+	_, err = entity.HybridUnwrapDataKey(sk, wrappedKey)
+	assert.Equal(t, entity.ErrIntegrity, err)
+}
@@ -0,0 +1,153 @@
+package entity_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"openabyss/entity"
+	"openabyss/server/storage"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function that generates a stored key of the algorithm holding a single version
+func newTestKeyStorage(t *testing.T, keyName string, algorithm entity.KeyAlgorithm) (storage.KeyStorage, *entity.KeyMaterial) {
+	material := &entity.KeyMaterial{
+		StoreName: entity.VersionKeyName(keyName, 1),
+		KeySize:   2048,
+		OAEPHash:  entity.DefaultOAEPHash,
+	}
+	assert.Nil(t, algorithm.Generate(material), "failed to generate '%s' key", algorithm.Name())
+
+	entry := storage.KeyStorage{
+		Name:      keyName,
+		Algorithm: algorithm.Name(),
+		KeySize:   material.KeySize,
+		OAEPHash:  material.OAEPHash,
+		Versions: []storage.KeyVersion{{
+			Version:        1,
+			CipherEncKey:   material.CipherEncKey,
+			PrivateKey_pem: material.PrivateKeyPem,
+			PublicKey_pem:  material.PublicKeyPem,
+			State:          storage.KeyVersion_Enabled,
+		}},
+	}
+	if algorithm.CanSign() {
+		signingKey, err := algorithm.GenerateSigningKey()
+		assert.Nil(t, err)
+		entry.SigningPublicKey_pem = string(signingKey.PublicKeyPem)
+	}
+	return entry, material
+}
+
+func TestKeyPackage_ExportImport_Success(t *testing.T) {
+	entity.KeyStorePath = t.TempDir()
+	dataKey, _ := entity.GenerateDataKey()
+
+	for _, algorithm := range entity.KeyAlgorithms() {
+		entry, material := newTestKeyStorage(t, "export-"+algorithm.Name(), algorithm)
+		wrappedKey, err := algorithm.WrapDataKey(material, dataKey)
+		if !assert.Nil(t, err, "failed to wrap data key using '%s'", algorithm.Name()) {
+			continue
+		}
+
+		pkg, err := entity.NewKeyTarPackage(entry.Name, entry)
+		if !assert.Nil(t, err, "failed to package '%s' key", algorithm.Name()) {
+			continue
+		}
+		buffer, err := pkg.Marshal()
+		assert.Nil(t, err)
+
+		// Import the package under another key name
+		importName := "import-" + algorithm.Name()
+		importedPkg, err := entity.UnmarshalKeyTarPackage(buffer)
+		if !assert.Nil(t, err, "failed to unpack '%s' key", algorithm.Name()) {
+			continue
+		}
+		assert.Nil(t, importedPkg.Validate(importName), "invalid '%s' key package", algorithm.Name())
+		assert.Nil(t, importedPkg.Import(importName), "failed to import '%s' key", algorithm.Name())
+		assert.Equal(t, entry.SigningPublicKey_pem, importedPkg.KeyStoreEntry.SigningPublicKey_pem)
+		assert.Equal(t, entry.Versions[0].CipherEncKey, importedPkg.KeyStoreEntry.Versions[0].CipherEncKey)
+
+		// Imported key unwraps data keys wrapped by the exported key
+		keyVersion := importedPkg.KeyStoreEntry.Versions[0]
+		importedMaterial := &entity.KeyMaterial{
+			StoreName:     entity.VersionKeyName(importName, keyVersion.Version),
+			CipherEncKey:  keyVersion.CipherEncKey,
+			PrivateKeyPem: keyVersion.PrivateKey_pem,
+			PublicKeyPem:  keyVersion.PublicKey_pem,
+			KeySize:       importedPkg.KeyStoreEntry.KeySize,
+			OAEPHash:      importedPkg.KeyStoreEntry.OAEPHash,
+		}
+		assert.Nil(t, algorithm.Load(importedMaterial), "failed to load imported '%s' key", algorithm.Name())
+		unwrappedKey, err := algorithm.UnwrapDataKey(importedMaterial, wrappedKey)
+		assert.Nil(t, err, "failed to unwrap data key using imported '%s' key", algorithm.Name())
+		assert.Equal(t, dataKey, unwrappedKey)
+	}
+}
+
+func TestKeyPackage_Validate_MissingKeyPair_Failure(t *testing.T) {
+	entity.KeyStorePath = t.TempDir()
+	algorithm, _ := entity.GetKeyAlgorithm("rsa")
+	entry, _ := newTestKeyStorage(t, "missing-rsa", algorithm)
+
+	pkg, err := entity.NewKeyTarPackage(entry.Name, entry)
+	assert.Nil(t, err)
+	pkg.Versions = nil
+	assert.NotNil(t, pkg.Validate("missing-rsa-import"))
+}
+
+// Helper function that serializes the package as is, without stamping its checksum
+func marshalTestKeyPackage(t *testing.T, pkg *entity.KeyTarPackage) []byte {
+	packageBuffer, err := json.Marshal(pkg)
+	assert.Nil(t, err)
+	compBuffer := bytes.NewBuffer(nil)
+	writer := gzip.NewWriter(compBuffer)
+	writer.Write(packageBuffer)
+	writer.Close()
+	return compBuffer.Bytes()
+}
+
+func TestKeyPackage_Unmarshal_Tampered_Failure(t *testing.T) {
+	entity.KeyStorePath = t.TempDir()
+	algorithm, _ := entity.GetKeyAlgorithm("none")
+	entry, _ := newTestKeyStorage(t, "tampered", algorithm)
+
+	pkg, err := entity.NewKeyTarPackage(entry.Name, entry)
+	assert.Nil(t, err)
+	_, err = pkg.Marshal()
+	assert.Nil(t, err)
+
+	pkg.KeyStoreEntry.Description = "tampered"
+	_, err = entity.UnmarshalKeyTarPackage(marshalTestKeyPackage(t, pkg))
+	assert.Equal(t, entity.ErrKeyPackageChecksum, err)
+
+	pkg.FormatVersion = entity.KeyTarPackageVersion + 1
+	_, err = entity.UnmarshalKeyTarPackage(marshalTestKeyPackage(t, pkg))
+	assert.Equal(t, entity.ErrKeyPackageVersion, err)
+
+	_, err = entity.UnmarshalKeyTarPackage([]byte("not a package"))
+	assert.NotNil(t, err)
+}
+
+func TestKeyPackage_Validate_MismatchedKeyPair_Failure(t *testing.T) {
+	entity.KeyStorePath = t.TempDir()
+	for _, algorithmName := range []string{"rsa", "x25519", "mlkem768-x25519"} {
+		algorithm, _ := entity.GetKeyAlgorithm(algorithmName)
+		entry, _ := newTestKeyStorage(t, "mismatched-"+algorithmName, algorithm)
+		otherEntry, _ := newTestKeyStorage(t, "other-"+algorithmName, algorithm)
+
+		pkg, err := entity.NewKeyTarPackage(entry.Name, entry)
+		assert.Nil(t, err)
+		otherPkg, err := entity.NewKeyTarPackage(otherEntry.Name, otherEntry)
+		assert.Nil(t, err)
+
+		// Public key of another keypair
+		pkg.KeyStoreEntry.Versions[0].PublicKey_pem = otherEntry.Versions[0].PublicKey_pem
+		for idx := range pkg.Versions {
+			pkg.Versions[idx].RawPublicKey = otherPkg.Versions[idx].RawPublicKey
+		}
+		assert.NotNil(t, pkg.Validate("mismatched-import"), "mismatched '%s' keypair validated", algorithmName)
+	}
+}
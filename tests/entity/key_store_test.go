@@ -0,0 +1,123 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"openabyss/server/storage"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper function that generates & saves a key of each algorithm name within a new key store
+func newTestKeyStore(t *testing.T, algorithmNames ...string) {
+	entity.KeyStorePath = t.TempDir()
+	storage.Internal.KeyMap = make(map[string]storage.KeyStorage)
+	for _, algorithmName := range algorithmNames {
+		algorithm, _ := entity.GetKeyAlgorithm(algorithmName)
+		entry, _ := newTestKeyStorage(t, "store-"+algorithmName, algorithm)
+		assert.Nil(t, entity.SaveKey(entry), "failed to save '%s' key", algorithmName)
+	}
+}
+
+func TestKeyStore_SaveLoad_Success(t *testing.T) {
+	newTestKeyStore(t, "rsa", "x25519")
+
+	quarantined, err := entity.LoadKeyStore()
+	assert.Nil(t, err)
+	assert.Empty(t, quarantined)
+	assert.Len(t, storage.Internal.KeyMap, 2)
+	assert.Equal(t, 1, entity.Store.Len())
+	assert.True(t, entity.Store.Has(entity.VersionKeyName("store-rsa", 1)))
+
+	// Renamed keys move their directory & Key Store entries
+	assert.Nil(t, entity.RenameKey("store-rsa", "renamed-rsa"))
+	assert.True(t, entity.Store.Has(entity.VersionKeyName("renamed-rsa", 1)))
+	assert.False(t, entity.Store.Has(entity.VersionKeyName("store-rsa", 1)))
+	assert.NotNil(t, entity.RenameKey("renamed-rsa", "store-x25519"))
+
+	quarantined, err = entity.LoadKeyStore()
+	assert.Nil(t, err)
+	assert.Empty(t, quarantined)
+	assert.Contains(t, storage.Internal.KeyMap, "renamed-rsa")
+	assert.NotContains(t, storage.Internal.KeyMap, "store-rsa")
+
+	assert.Nil(t, entity.RemoveKey("renamed-rsa"))
+	assert.Equal(t, 0, entity.Store.Len())
+	assert.NoDirExists(t, entity.KeyDir("renamed-rsa"))
+}
+
+func TestKeyStore_BackupRestore_Success(t *testing.T) {
+	newTestKeyStore(t, "rsa")
+	_, err := entity.LoadKeyStore()
+	assert.Nil(t, err)
+	pubKeyPem, err := os.ReadFile(path.Join(entity.KeyDir("store-rsa"), "v1.pub"))
+	assert.Nil(t, err)
+
+	backup, err := entity.BackupKey("store-rsa")
+	assert.Nil(t, err)
+	newBackup, err := entity.BackupKey("new-key")
+	assert.Nil(t, err)
+
+	// Overwritten keypair file, added file & key, removed Key Store entry
+	assert.Nil(t, os.WriteFile(path.Join(entity.KeyDir("store-rsa"), "v1.pub"), []byte("overwritten"), 0644))
+	assert.Nil(t, os.WriteFile(path.Join(entity.KeyDir("store-rsa"), "v2"), []byte("added"), 0600))
+	assert.Nil(t, os.MkdirAll(entity.KeyDir("new-key"), 0700))
+	entity.Store.RemoveVersions("store-rsa")
+
+	assert.Nil(t, backup.Restore())
+	assert.Nil(t, newBackup.Restore())
+	restoredPem, err := os.ReadFile(path.Join(entity.KeyDir("store-rsa"), "v1.pub"))
+	assert.Nil(t, err)
+	assert.Equal(t, pubKeyPem, restoredPem)
+	assert.NoFileExists(t, path.Join(entity.KeyDir("store-rsa"), "v2"))
+	assert.NoDirExists(t, entity.KeyDir("new-key"))
+	assert.True(t, entity.Store.Has(entity.VersionKeyName("store-rsa", 1)))
+	assert.Contains(t, storage.Internal.KeyMap, "store-rsa")
+}
+
+func TestKeyStore_Load_Quarantine_Failure(t *testing.T) {
+	newTestKeyStore(t, "rsa", "x25519", "none")
+
+	// Tampered keypair file, tampered key material & stray file
+	assert.Nil(t, os.WriteFile(path.Join(entity.KeyDir("store-rsa"), "v1.pub"), []byte("tampered"), 0644))
+	entry := storage.Internal.KeyMap["store-x25519"]
+	entry.Versions[0].PrivateKey_pem = "tampered"
+	assert.Nil(t, entity.SaveKey(entry))
+	assert.Nil(t, os.WriteFile(path.Join(entity.KeyStorePath, "stray.v1"), []byte("stray"), 0600))
+
+	quarantined, err := entity.LoadKeyStore()
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"store-rsa", "store-x25519", "stray.v1"}, quarantined)
+	assert.Len(t, storage.Internal.KeyMap, 1)
+	assert.Contains(t, storage.Internal.KeyMap, "store-none")
+	assert.Equal(t, 0, entity.Store.Len())
+	assert.NoDirExists(t, entity.KeyDir("store-rsa"))
+}
+
+func TestKeyStore_MigrateLegacyKeyStore_Success(t *testing.T) {
+	newTestKeyStore(t, "rsa", "none")
+
+	// Flat keypair files stored prior to versions, metadata held within internal storage
+	rsaDir := entity.KeyDir("store-rsa")
+	assert.Nil(t, os.Rename(path.Join(rsaDir, "v1"), path.Join(entity.KeyStorePath, "legacy-rsa")))
+	assert.Nil(t, os.Rename(path.Join(rsaDir, "v1.pub"), path.Join(entity.KeyStorePath, "legacy-rsa.pub")))
+	assert.Nil(t, os.RemoveAll(rsaDir))
+	assert.Nil(t, os.RemoveAll(entity.KeyDir("store-none")))
+	entry := storage.Internal.KeyMap["store-rsa"]
+	delete(storage.Internal.KeyMap, "store-rsa")
+	entry.Name = "legacy-rsa"
+	storage.Internal.KeyMap["legacy-rsa"] = entry
+
+	migrated, err := entity.MigrateLegacyKeyStore()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, migrated)
+	assert.FileExists(t, path.Join(entity.KeyDir("legacy-rsa"), "v1"))
+
+	quarantined, err := entity.LoadKeyStore()
+	assert.Nil(t, err)
+	assert.Empty(t, quarantined)
+	assert.Len(t, storage.Internal.KeyMap, 2)
+	assert.True(t, entity.Store.Has(entity.VersionKeyName("legacy-rsa", 1)))
+}
@@ -0,0 +1,48 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasterKey_SealUnseal_Success(t *testing.T) {
+	key, _ := entity.GenerateMasterKey()
+	assert.Nil(t, entity.SetMasterKey(key))
+	defer entity.ClearMasterKey()
+
+	check, err := entity.MasterKeyCheck()
+	assert.Nil(t, err)
+	assert.True(t, entity.VerifyMasterKey(check))
+
+	sealed, err := entity.SealString("private key material")
+	assert.Nil(t, err)
+	assert.True(t, entity.IsSealedString(sealed))
+
+	value, err := entity.UnsealString(sealed)
+	assert.Nil(t, err)
+	assert.Equal(t, "private key material", value)
+
+	// Values stored prior to the master key are returned as is
+	value, err = entity.UnsealString("plaintext")
+	assert.Nil(t, err)
+	assert.Equal(t, "plaintext", value)
+}
+
+func TestMasterKey_Unseal_WrongKey_Failure(t *testing.T) {
+	salt, _ := entity.GenerateMasterKeySalt()
+	key := entity.DeriveMasterKey([]byte("passphrase"), salt, 1, 1024, 1)
+	assert.Nil(t, entity.SetMasterKey(key))
+	defer entity.ClearMasterKey()
+
+	check, _ := entity.MasterKeyCheck()
+	sealed, _ := entity.SealString("private key material")
+
+	wrongKey := entity.DeriveMasterKey([]byte("wrong passphrase"), salt, 1, 1024, 1)
+	assert.Nil(t, entity.SetMasterKey(wrongKey))
+	assert.False(t, entity.VerifyMasterKey(check))
+
+	_, err := entity.UnsealString(sealed)
+	assert.ErrorIs(t, err, entity.ErrIntegrity)
+}
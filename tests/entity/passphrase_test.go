@@ -0,0 +1,46 @@
+package entity_test
+
+import (
+	"encoding/binary"
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassphrase_SealOpen_Success(t *testing.T) {
+	sealed, err := entity.SealWithPassphrase([]byte("key package"), []byte("passphrase"))
+	assert.Nil(t, err)
+	assert.True(t, entity.IsPassphraseSealed(sealed))
+
+	data, err := entity.OpenWithPassphrase(sealed, []byte("passphrase"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("key package"), data)
+}
+
+func TestPassphrase_Open_WrongPassphrase_Failure(t *testing.T) {
+	sealed, _ := entity.SealWithPassphrase([]byte("key package"), []byte("passphrase"))
+
+	_, err := entity.OpenWithPassphrase(sealed, []byte("wrong passphrase"))
+	assert.ErrorIs(t, err, entity.ErrIntegrity)
+
+	// Tampered derivation parameters fail authentication
+	sealed[8] ^= 1
+	_, err = entity.OpenWithPassphrase(sealed, []byte("passphrase"))
+	assert.NotNil(t, err)
+}
+
+func TestPassphrase_Open_CostlyParameters_Failure(t *testing.T) {
+	sealed, _ := entity.SealWithPassphrase([]byte("key package"), []byte("passphrase"))
+
+	// Derivation parameters above the server's are refused prior to deriving the key
+	costly := append([]byte(nil), sealed...)
+	binary.BigEndian.PutUint32(costly[9:13], entity.Argon2Memory+1)
+	_, err := entity.OpenWithPassphrase(costly, []byte("passphrase"))
+	assert.EqualError(t, err, "invalid passphrase derivation parameters")
+
+	costly = append([]byte(nil), sealed...)
+	costly[13] = 255
+	_, err = entity.OpenWithPassphrase(costly, []byte("passphrase"))
+	assert.EqualError(t, err, "invalid passphrase derivation parameters")
+}
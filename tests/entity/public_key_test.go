@@ -0,0 +1,79 @@
+package entity_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"openabyss/entity"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicKey_MarshalPublicKey_RSA_Success(t *testing.T) {
+	sk, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkcs1Pem := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&sk.PublicKey)})
+	pk, err := entity.ParsePublicKeyPem(pkcs1Pem)
+	assert.Nil(t, err)
+
+	// PKIX PEM fingerprinted over its DER
+	pkixPem, err := entity.MarshalPublicKey(pk, entity.PublicKeyFormat_PEM)
+	assert.Nil(t, err)
+	decodedKey, _ := pem.Decode(pkixPem)
+	assert.Equal(t, "PUBLIC KEY", decodedKey.Type)
+	sum := sha256.Sum256(decodedKey.Bytes)
+	fingerprint, err := entity.PublicKeyFingerprint(pk)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), fingerprint)
+
+	jwk, err := entity.MarshalPublicKey(pk, entity.PublicKeyFormat_JWK)
+	assert.Nil(t, err)
+	var jwkMembers map[string]string
+	assert.Nil(t, json.Unmarshal(jwk, &jwkMembers))
+	assert.Equal(t, "RSA", jwkMembers["kty"])
+	assert.Equal(t, "AQAB", jwkMembers["e"])
+
+	authorizedKey, err := entity.MarshalPublicKey(pk, entity.PublicKeyFormat_SSH)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(authorizedKey), "ssh-rsa "))
+}
+
+func TestPublicKey_MarshalPublicKey_Algorithms_Success(t *testing.T) {
+	pk, _, _ := ed25519.GenerateKey(rand.Reader)
+	pkDer, _ := x509.MarshalPKIXPublicKey(pk)
+	ed25519Pk, err := entity.ParsePublicKeyPem(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkDer}))
+	assert.Nil(t, err)
+	authorizedKey, err := entity.MarshalPublicKey(ed25519Pk, entity.PublicKeyFormat_SSH)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(authorizedKey), "ssh-ed25519 "))
+
+	// Keys holding their public key within the version
+	for _, algorithmName := range []string{entity.ECDH_X25519, entity.ECDH_P256, entity.KEM_MLKEM768_X25519} {
+		algorithm, _ := entity.GetKeyAlgorithm(algorithmName)
+		material := &entity.KeyMaterial{}
+		assert.Nil(t, algorithm.Generate(material))
+
+		pk, err := entity.ParsePublicKeyPem(algorithm.PublicKeyPem(material))
+		if !assert.Nil(t, err, "failed to parse '%s' public key", algorithmName) {
+			continue
+		}
+		publicKeyPem, err := entity.MarshalPublicKey(pk, entity.PublicKeyFormat_PEM)
+		assert.Nil(t, err)
+		assert.Equal(t, material.PublicKeyPem, string(publicKeyPem))
+		fingerprint, err := entity.PublicKeyFingerprint(pk)
+		assert.Nil(t, err)
+		assert.Len(t, fingerprint, 64)
+
+		// Only rsa & ed25519 keys are encoded as authorized_keys lines
+		_, err = entity.MarshalPublicKey(pk, entity.PublicKeyFormat_SSH)
+		assert.NotNil(t, err)
+		_, err = entity.MarshalPublicKey(pk, entity.PublicKeyFormat_JWK)
+		assert.Equal(t, algorithmName == entity.KEM_MLKEM768_X25519, err != nil, "unexpected '%s' jwk result", algorithmName)
+	}
+}
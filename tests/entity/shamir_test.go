@@ -0,0 +1,39 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShamir_SplitCombine_Success(t *testing.T) {
+	secret, _ := entity.GenerateMasterKey()
+	shares, err := entity.SplitSecret(secret, 5, 3)
+	assert.Nil(t, err)
+	assert.Len(t, shares, 5)
+
+	// Any threshold number of shares recovers the secret
+	combined, err := entity.CombineShares([][]byte{shares[4], shares[0], shares[2]})
+	assert.Nil(t, err)
+	assert.Equal(t, secret, combined)
+
+	combined, err = entity.CombineShares(shares)
+	assert.Nil(t, err)
+	assert.Equal(t, secret, combined)
+}
+
+func TestShamir_Combine_BelowThreshold_Failure(t *testing.T) {
+	secret, _ := entity.GenerateMasterKey()
+	shares, _ := entity.SplitSecret(secret, 5, 3)
+
+	combined, err := entity.CombineShares(shares[:2])
+	assert.Nil(t, err)
+	assert.NotEqual(t, secret, combined)
+
+	_, err = entity.CombineShares([][]byte{shares[0], shares[0], shares[1]})
+	assert.NotNil(t, err)
+
+	_, err = entity.SplitSecret(secret, 2, 3)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,37 @@
+package entity_test
+
+import (
+	"fmt"
+	"openabyss/entity"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityStore_ConcurrentAccess_Success(t *testing.T) {
+	store := entity.EntityStore{
+		Keys: make(map[string]entity.Entity),
+	}
+
+	// Modify & read the store from concurrent goroutines
+	var wg sync.WaitGroup
+	for idx := 0; idx < 8; idx++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			name := fmt.Sprintf("key-%d.v1", idx)
+			store.Add(entity.Entity{Name: name})
+			assert.True(t, store.Has(name), "entity not added")
+			assert.Equal(t, name, store.Get(name).Name)
+			store.Rename(name, fmt.Sprintf("renamed-%d.v1", idx))
+			store.Len()
+		}(idx)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 8, store.Len(), "entities missing")
+	store.RemoveVersions("renamed-0")
+	store.Remove("renamed-1.v1")
+	assert.Equal(t, 6, store.Len(), "entities not removed")
+}
@@ -0,0 +1,31 @@
+package entity_test
+
+import (
+	"openabyss/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport_SealOpen_Success(t *testing.T) {
+	sk, _ := entity.GenerateTransportKey()
+	pkPem, _ := entity.MarshalECDHPublicKey(sk.PublicKey())
+
+	sealed, err := entity.SealForTransport([]byte("key package"), pkPem)
+	assert.Nil(t, err)
+	assert.True(t, entity.IsTransportSealed(sealed))
+
+	data, err := entity.OpenTransport(sealed, sk)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("key package"), data)
+}
+
+func TestTransport_Open_OtherKey_Failure(t *testing.T) {
+	sk, _ := entity.GenerateTransportKey()
+	pkPem, _ := entity.MarshalECDHPublicKey(sk.PublicKey())
+	sealed, _ := entity.SealForTransport([]byte("key package"), pkPem)
+
+	otherSk, _ := entity.GenerateTransportKey()
+	_, err := entity.OpenTransport(sealed, otherSk)
+	assert.ErrorIs(t, err, entity.ErrIntegrity)
+}
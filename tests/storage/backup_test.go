@@ -37,3 +37,22 @@ func TestStorageBackup_SimpleBackup_File_Success(t *testing.T) {
 	full_storage_test_path := path.Join(wd, storage.InternalStoragePath)
 	os.RemoveAll(full_storage_test_path)
 }
+
+func TestStorageBackup_ReadBackupMasterKey_Success(t *testing.T) {
+	storage.InternalStoragePath = ".storage-test"
+	wd, _ := os.Getwd()
+	storage_dir := path.Join(wd, storage.InternalStoragePath)
+	backup_dir := path.Join(storage_dir, storage.BackupStoragePath)
+	assert.Nil(t, os.MkdirAll(backup_dir, 0755), "failed to create backup path")
+	defer os.RemoveAll(storage_dir)
+
+	// Back up internal storage holding a master key
+	assert.Nil(t, ioutil.WriteFile(path.Join(storage_dir, "internal.json"), []byte(`{"masterKey":{"kdf":"file","check":"sealed-check"}}`), 0644))
+	backup_filepath := storage.InvokeNewBackup()
+
+	// Check master key read from backup
+	masterKey, err := storage.ReadBackupMasterKey(backup_filepath)
+	assert.Nil(t, err, "failed to read backup master key")
+	assert.NotNil(t, masterKey, "backup master key not read")
+	assert.Equal(t, "sealed-check", masterKey.Check)
+}
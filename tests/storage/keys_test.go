@@ -0,0 +1,104 @@
+package storage_test
+
+import (
+	"openabyss/server/storage"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyStorage_MigrateLegacyVersion_Success(t *testing.T) {
+	key := storage.KeyStorage{
+		Name:                    "key",
+		CipherEncKey:            "legacy-cipher-key",
+		CreatedAt_UnixTimestamp: 1000,
+	}
+
+	assert.True(t, key.MigrateLegacyVersion(), "legacy key was not migrated")
+	assert.Empty(t, key.CipherEncKey, "legacy cipher key was not moved")
+	assert.Len(t, key.Versions, 1)
+
+	keyVersion := key.GetVersion(1)
+	assert.NotNil(t, keyVersion, "first version not found")
+	assert.Equal(t, "legacy-cipher-key", keyVersion.CipherEncKey)
+	assert.Equal(t, storage.KeyVersion_Enabled, keyVersion.State)
+	assert.Equal(t, uint64(1000), keyVersion.CreatedAt_UnixTimestamp)
+
+	// Versioned keys are left untouched
+	assert.False(t, key.MigrateLegacyVersion(), "versioned key was migrated")
+}
+
+func TestKeyStorage_LatestVersion_Success(t *testing.T) {
+	key := storage.KeyStorage{
+		Versions: []storage.KeyVersion{
+			{Version: 1, State: storage.KeyVersion_Disabled},
+			{Version: 2, State: storage.KeyVersion_Enabled},
+		},
+	}
+
+	assert.Equal(t, uint32(2), key.LatestVersion().Version)
+	assert.Nil(t, key.GetVersion(3), "non-existent version found")
+
+	// Modifying the returned version modifies the key's version
+	key.GetVersion(1).State = storage.KeyVersion_Destroyed
+	assert.Equal(t, storage.KeyVersion_Destroyed, key.Versions[0].State)
+}
+
+func TestKeyStorage_SetState_Success(t *testing.T) {
+	// Keys stored prior to lifecycle states are active
+	key := storage.KeyStorage{Name: "key"}
+	assert.Equal(t, storage.KeyState_Active, key.GetState())
+
+	key.SetState(storage.KeyState_Disabled)
+	key.SetState(storage.KeyState_DecryptOnly)
+	assert.Equal(t, storage.KeyState_DecryptOnly, key.GetState())
+	assert.Len(t, key.StateTransitions, 2)
+	assert.Equal(t, storage.KeyState_Disabled, key.StateTransitions[0].State)
+	assert.NotZero(t, key.StateTransitions[1].ChangedAt_UnixTimestamp)
+	assert.Equal(t, key.StateTransitions[1].ChangedAt_UnixTimestamp, key.ModifiedAt_UnixTimestamp)
+}
+
+func TestKeyStorage_GetStatus_Success(t *testing.T) {
+	key := storage.KeyStorage{
+		Name:                    "key",
+		NotBefore_UnixTimestamp: 1000,
+		ExpiresAt_UnixTimestamp: 2000,
+	}
+
+	// Active keys follow their not-before & expiry times
+	assert.Equal(t, storage.KeyState_Pending, key.GetStatus(999))
+	assert.Equal(t, storage.KeyState_Active, key.GetStatus(1000))
+	assert.Equal(t, storage.KeyState_Expired, key.GetStatus(2000))
+
+	// Removing the expiry re-activates expired keys
+	key.SetState(storage.KeyState_Expired)
+	key.ExpiresAt_UnixTimestamp = 0
+	assert.Equal(t, storage.KeyState_Active, key.GetStatus(2000))
+
+	// Other lifecycle states are kept
+	key.SetState(storage.KeyState_Disabled)
+	assert.Equal(t, storage.KeyState_Disabled, key.GetStatus(999))
+}
+
+func TestKeyStorage_RotationDue_Success(t *testing.T) {
+	key := storage.KeyStorage{
+		Versions: []storage.KeyVersion{
+			{Version: 1, CreatedAt_UnixTimestamp: 1000},
+		},
+	}
+
+	// Keys without a rotation policy are never rotated
+	assert.False(t, key.RotationDue(1_000_000))
+
+	// Rotated once the latest version is older
+	key.RotationPolicy = &storage.KeyRotationPolicy{Every_UnixTimestamp: 500}
+	assert.False(t, key.RotationDue(1499))
+	assert.True(t, key.RotationDue(1500))
+
+	// Rotated once the latest version encrypted as many files
+	key.RotationPolicy = &storage.KeyRotationPolicy{AfterEncryptions: 2}
+	key.Versions[0].Encryptions = 1
+	assert.False(t, key.RotationDue(1_000_000))
+	key.Versions[0].Encryptions = 2
+	assert.True(t, key.RotationDue(1000))
+}
@@ -242,3 +242,25 @@ func TestFileStorage_Remove_RecursiveSubStorage_Success(t *testing.T) {
 	assert.NotNil(t, err, "internal storage did not fail to find internal file")
 	assert.Nil(t, fsFile, "internal storage found removed internal file")
 }
+
+func TestFileStorage_GetStorageByKeyUid_Success(t *testing.T) {
+	storage.Internal = storage.FileStorageMap{}
+	for _, filePath := range []string{"/file1", "/path/to/file2", "/file3"} {
+		fileId := sha256.Sum256([]byte(filePath))
+		fsFile, err := storage.Internal.Store(hex.EncodeToString(fileId[:]), filePath, 255, storage.Type_File, false)
+		assert.Nil(t, err, "internal store failed")
+
+		fsFile.KeyUid = "uid1"
+		if filePath == "/file3" {
+			fsFile.KeyUid = "uid2"
+		}
+		assert.Nil(t, storage.Internal.UpdateStorage(filePath, *fsFile), "internal storage update failed")
+	}
+
+	paths := []string{}
+	for _, fsFile := range storage.Internal.GetStorageByKeyUid("uid1") {
+		paths = append(paths, fsFile.Path)
+	}
+	assert.ElementsMatch(t, []string{"/file1", "/path/to/file2"}, paths, "dependent files mismatch")
+	assert.Empty(t, storage.Internal.GetStorageByKeyUid(""), "unknown key matched files")
+}
@@ -0,0 +1,109 @@
+package utils_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"openabyss/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+// Helper function that encodes the ed25519 private key as a JSON Web Key
+func ed25519JWK(sk ed25519.PrivateKey) []byte {
+	jwk, _ := json.Marshal(map[string]string{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"d":   base64.RawURLEncoding.EncodeToString(sk.Seed()),
+		"x":   base64.RawURLEncoding.EncodeToString(sk.Public().(ed25519.PublicKey)),
+	})
+	return jwk
+}
+
+func TestKeyFormats_ParseStandardPrivateKey_RSA_Success(t *testing.T) {
+	sk, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(sk)
+	openSSH, _ := ssh.MarshalPrivateKeyWithPassphrase(sk, "", []byte("secret"))
+	jwk, _ := json.Marshal(map[string]string{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(sk.N.Bytes()),
+		"e":   "AQAB",
+		"d":   base64.RawURLEncoding.EncodeToString(sk.D.Bytes()),
+		"p":   base64.RawURLEncoding.EncodeToString(sk.Primes[0].Bytes()),
+		"q":   base64.RawURLEncoding.EncodeToString(sk.Primes[1].Bytes()),
+	})
+
+	for format, rawKey := range map[string][]byte{
+		"pkcs1":   pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(sk)}),
+		"pkcs8":   pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+		"openssh": pem.EncodeToMemory(openSSH),
+		"jwk":     jwk,
+	} {
+		keyFormat := format
+		if format == "pkcs1" || format == "pkcs8" {
+			keyFormat = utils.KeyFormat_PEM
+		}
+		key, err := utils.ParseStandardPrivateKey(keyFormat, rawKey, "secret")
+		if assert.Nil(t, err, "failed to parse '%s' rsa key", format) {
+			assert.True(t, sk.Equal(key), "parsed '%s' rsa key doesn't match", format)
+		}
+	}
+}
+
+func TestKeyFormats_ParseStandardPrivateKey_Ed25519_Success(t *testing.T) {
+	_, sk, _ := ed25519.GenerateKey(rand.Reader)
+	openSSH, _ := ssh.MarshalPrivateKey(sk, "")
+
+	for format, rawKey := range map[string][]byte{
+		utils.KeyFormat_PEM:     utils.ED25519_to_pem_sk(sk),
+		utils.KeyFormat_OpenSSH: pem.EncodeToMemory(openSSH),
+		utils.KeyFormat_JWK:     ed25519JWK(sk),
+	} {
+		key, err := utils.ParseStandardPrivateKey(format, rawKey, "")
+		if assert.Nil(t, err, "failed to parse '%s' ed25519 key", format) {
+			assert.Equal(t, sk, key)
+		}
+
+		signingKey, err := utils.ParseSigningKey(rawKey)
+		assert.Nil(t, err, "failed to parse '%s' signing key", format)
+		assert.Equal(t, sk, signingKey)
+	}
+}
+
+func TestKeyFormats_ParseStandardPrivateKey_Failure(t *testing.T) {
+	_, sk, _ := ed25519.GenerateKey(rand.Reader)
+	_, otherSk, _ := ed25519.GenerateKey(rand.Reader)
+
+	// Public key of another key
+	var jwk map[string]string
+	json.Unmarshal(ed25519JWK(sk), &jwk)
+	jwk["x"] = base64.RawURLEncoding.EncodeToString(otherSk.Public().(ed25519.PublicKey))
+	mismatchedJWK, _ := json.Marshal(jwk)
+	_, err := utils.ParseStandardPrivateKey(utils.KeyFormat_JWK, mismatchedJWK, "")
+	assert.NotNil(t, err)
+
+	// Passphrase protected OpenSSH key without its passphrase
+	openSSH, _ := ssh.MarshalPrivateKeyWithPassphrase(sk, "", []byte("secret"))
+	_, err = utils.ParseStandardPrivateKey(utils.KeyFormat_OpenSSH, pem.EncodeToMemory(openSSH), "")
+	assert.Equal(t, utils.ErrKeyPassphraseMissing, err)
+
+	// Key types other than rsa & ed25519
+	ecdsaSk, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(ecdsaSk)
+	_, err = utils.ParseStandardPrivateKey(utils.KeyFormat_PEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), "")
+	assert.NotNil(t, err)
+
+	// Formats not matching the key
+	_, err = utils.ParseStandardPrivateKey(utils.KeyFormat_PEM, ed25519JWK(sk), "")
+	assert.NotNil(t, err)
+	_, err = utils.ParseStandardPrivateKey("der", utils.ED25519_to_pem_sk(sk), "")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Standard private key formats keys are imported from
+const (
+	KeyFormat_PEM     = "pem"     // PKCS#1 or PKCS#8 PEM
+	KeyFormat_OpenSSH = "openssh" // OpenSSH private key
+	KeyFormat_JWK     = "jwk"     // JSON Web Key
+)
+
+// Supported standard private key formats
+var KeyFormats = []string{KeyFormat_PEM, KeyFormat_OpenSSH, KeyFormat_JWK}
+
+// Returned when parsing a passphrase protected key without its passphrase
+var ErrKeyPassphraseMissing = errors.New("key is passphrase protected, passphrase required")
+
+// JSON Web Key, holding the private members of RSA & Ed25519 keys (RFC 7517, 7518, 8037)
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	D   string `json:"d"`
+	P   string `json:"p"`
+	Q   string `json:"q"`
+	X   string `json:"x"`
+}
+
+// Parses the RSA or Ed25519 private key of the given standard format. The passphrase
+//  is only used by passphrase protected OpenSSH keys.
+func ParseStandardPrivateKey(format string, rawKey []byte, passphrase string) (crypto.PrivateKey, error) {
+	var key crypto.PrivateKey
+	var err error
+	switch format {
+	case KeyFormat_PEM:
+		key, err = parsePemPrivateKey(rawKey)
+	case KeyFormat_OpenSSH:
+		key, err = parseOpenSSHPrivateKey(rawKey, passphrase)
+	case KeyFormat_JWK:
+		key, err = parseJWKPrivateKey(rawKey)
+	default:
+		return nil, errors.New("key format '" + format + "' not supported")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if err := k.Validate(); err != nil {
+			return nil, err
+		}
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	case *ed25519.PrivateKey:
+		return *k, nil
+	default:
+		return nil, errors.New("key type not supported, expected an rsa or ed25519 key")
+	}
+}
+
+// Parses the Ed25519 signing key of any standard format, PKCS#8 PEM being the format
+//  stored by the client when generating keys
+func ParseSigningKey(rawKey []byte) (ed25519.PrivateKey, error) {
+	for _, format := range KeyFormats {
+		if key, err := ParseStandardPrivateKey(format, rawKey, ""); err == nil {
+			if sk, ok := key.(ed25519.PrivateKey); ok {
+				return sk, nil
+			}
+			return nil, errors.New("key is not an ed25519 key")
+		}
+	}
+	return nil, errors.New("no ed25519 signing key found")
+}
+
+// Parses the PKCS#1 or PKCS#8 PEM encoded private key
+func parsePemPrivateKey(rawKey []byte) (crypto.PrivateKey, error) {
+	decodedKey, _ := pem.Decode(rawKey)
+	if decodedKey == nil {
+		return nil, errors.New("no pem encoded key found")
+	}
+	switch decodedKey.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(decodedKey.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(decodedKey.Bytes)
+	default:
+		return nil, errors.New("pem type '" + decodedKey.Type + "' not supported")
+	}
+}
+
+// Parses the OpenSSH private key, decrypting it using the passphrase if protected
+func parseOpenSSHPrivateKey(rawKey []byte, passphrase string) (crypto.PrivateKey, error) {
+	key, err := ssh.ParseRawPrivateKey(rawKey)
+	if _, ok := err.(*ssh.PassphraseMissingError); ok {
+		if passphrase == "" {
+			return nil, ErrKeyPassphraseMissing
+		}
+		return ssh.ParseRawPrivateKeyWithPassphrase(rawKey, []byte(passphrase))
+	}
+	return key, err
+}
+
+// Parses the RSA or Ed25519 ("OKP") private JSON Web Key
+func parseJWKPrivateKey(rawKey []byte) (crypto.PrivateKey, error) {
+	var jwk jsonWebKey
+	if err := json.Unmarshal(rawKey, &jwk); err != nil {
+		return nil, errors.New("no json web key found")
+	}
+	if jwk.D == "" {
+		return nil, errors.New("json web key holds no private key")
+	}
+
+	switch jwk.Kty {
+	case "RSA":
+		members := map[string]*big.Int{}
+		for name, value := range map[string]string{"n": jwk.N, "e": jwk.E, "d": jwk.D, "p": jwk.P, "q": jwk.Q} {
+			decoded, err := base64.RawURLEncoding.DecodeString(value)
+			if err != nil || len(decoded) == 0 {
+				return nil, errors.New("invalid rsa json web key member '" + name + "'")
+			}
+			members[name] = new(big.Int).SetBytes(decoded)
+		}
+		if !members["e"].IsInt64() {
+			return nil, errors.New("invalid rsa json web key exponent")
+		}
+
+		sk := &rsa.PrivateKey{
+			PublicKey: rsa.PublicKey{N: members["n"], E: int(members["e"].Int64())},
+			D:         members["d"],
+			Primes:    []*big.Int{members["p"], members["q"]},
+		}
+		sk.Precompute()
+		return sk, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, errors.New("json web key curve '" + jwk.Crv + "' not supported")
+		}
+		seed, err := base64.RawURLEncoding.DecodeString(jwk.D)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, errors.New("invalid ed25519 json web key")
+		}
+		sk := ed25519.NewKeyFromSeed(seed)
+		if x, err := base64.RawURLEncoding.DecodeString(jwk.X); err != nil || !sk.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(x)) {
+			return nil, errors.New("ed25519 json web key public key doesn't match the private key")
+		}
+		return sk, nil
+	default:
+		return nil, errors.New("json web key type '" + jwk.Kty + "' not supported")
+	}
+}